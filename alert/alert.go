@@ -0,0 +1,155 @@
+package alert
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+)
+
+type Kind string
+
+const (
+	KindUsedPercent    Kind = "used_percent"
+	KindSinglePurchase Kind = "single_purchase"
+	KindCardProducts   Kind = "card_products"
+)
+
+const MaxMonths = 24
+
+var Kinds = []Kind{KindUsedPercent, KindSinglePurchase, KindCardProducts}
+
+var ErrInvalidKind = errors.New("tipo de alerta inválido")
+var ErrInvalidMonths = errors.New("quantidade de meses inválida")
+var ErrInvalidCount = errors.New("quantidade de produtos inválida")
+var ErrInvalidCard = errors.New("cartão inválido")
+
+type Rule struct {
+	Kind      Kind    `json:"kind"`
+	Threshold float64 `json:"threshold"`
+	Months    int     `json:"months,omitempty"`
+	Card      string  `json:"card,omitempty"`
+}
+
+type Alert struct {
+	Rule    Rule
+	Message string
+}
+
+func ParseKind(value string) (Kind, bool) {
+	for _, kind := range Kinds {
+		if strings.EqualFold(strings.TrimSpace(value), string(kind)) {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
+func (r Rule) Validate() error {
+	switch r.Kind {
+	case KindUsedPercent:
+		if r.Months < 1 || r.Months > MaxMonths {
+			return ErrInvalidMonths
+		}
+		return product.ValidateSafePercentage(r.Threshold)
+	case KindSinglePurchase:
+		return product.ValidateSafePercentage(r.Threshold)
+	case KindCardProducts:
+		if strings.TrimSpace(r.Card) == "" {
+			return ErrInvalidCard
+		}
+		if r.Threshold < 0 || r.Threshold != math.Trunc(r.Threshold) {
+			return ErrInvalidCount
+		}
+		return nil
+	}
+	return ErrInvalidKind
+}
+
+func (r Rule) Describe() string {
+	switch r.Kind {
+	case KindUsedPercent:
+		return i18n.Sprintf("Uso acima de %s%% em algum dos próximos %d mês(es)", formatPercent(r.Threshold), r.Months)
+	case KindSinglePurchase:
+		return i18n.Sprintf("Compra única acima de %s%% do lucro mensal", formatPercent(r.Threshold))
+	case KindCardProducts:
+		return i18n.Sprintf("Mais de %d produto(s) ativo(s) no cartão %s", int(r.Threshold), r.Card)
+	}
+	return string(r.Kind)
+}
+
+func Evaluate(list product.ProductList, rules []Rule, now time.Time) []Alert {
+	var alerts []Alert
+	for _, rule := range rules {
+		if rule.Validate() != nil {
+			continue
+		}
+		for _, message := range rule.evaluate(list, now) {
+			alerts = append(alerts, Alert{Rule: rule, Message: message})
+		}
+	}
+	return alerts
+}
+
+func (r Rule) evaluate(list product.ProductList, now time.Time) []string {
+	year, month := now.Year(), int(now.Month())
+
+	switch r.Kind {
+	case KindUsedPercent:
+		if list.MonthlyProfit <= 0 {
+			return nil
+		}
+		start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
+		for i := 0; i < r.Months; i++ {
+			date := start.AddDate(0, i, 0)
+			summary := report.Summarize(list, date.Year(), int(date.Month()))
+			if summary.UsedPercent > r.Threshold {
+				return []string{i18n.Sprintf("As parcelas usam %s%% do lucro em %02d/%d (%s).",
+					money.FormatNumber(summary.UsedPercent), summary.Month, summary.Year, r.Describe())}
+			}
+		}
+
+	case KindSinglePurchase:
+		if list.MonthlyProfit <= 0 {
+			return nil
+		}
+		var messages []string
+		for _, p := range list.Products {
+			if !p.IsActiveInMonth(year, month) {
+				continue
+			}
+			percent := p.TotalValue / list.MonthlyProfit * 100
+			if percent > r.Threshold {
+				messages = append(messages, i18n.Sprintf("A compra '%s' (%s) equivale a %s%% do lucro mensal (%s).",
+					p.Name, money.Format(p.TotalValue), money.FormatNumber(percent), r.Describe()))
+			}
+		}
+		return messages
+
+	case KindCardProducts:
+		active, _ := list.ActiveInMonth(year, month)
+		count := 0
+		for _, p := range active {
+			if strings.EqualFold(strings.TrimSpace(p.Card), strings.TrimSpace(r.Card)) {
+				count++
+			}
+		}
+		if count > int(r.Threshold) {
+			return []string{i18n.Sprintf("O cartão %s tem %d produto(s) ativo(s) (%s).", r.Card, count, r.Describe())}
+		}
+	}
+	return nil
+}
+
+func formatPercent(value float64) string {
+	if value == math.Trunc(value) {
+		return strconv.Itoa(int(value))
+	}
+	return money.FormatNumber(value)
+}
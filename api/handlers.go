@@ -0,0 +1,404 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+const maxSummaryMonths = 60
+
+var errInvalidQuery = errors.New("parâmetro inválido")
+var errCurrencyChange = errors.New("a moeda de um produto não pode ser alterada")
+
+type productRequest struct {
+	Name          *string  `json:"name"`
+	TotalValue    *float64 `json:"total_value"`
+	OriginalValue *float64 `json:"original_value"`
+	Installments  *int     `json:"installments"`
+	Category      *string  `json:"category"`
+	Card          *string  `json:"card"`
+	Currency      *string  `json:"currency"`
+	ExchangeRate  *float64 `json:"exchange_rate"`
+	WithIOF       bool     `json:"with_iof"`
+}
+
+type incomeBody struct {
+	MonthlyProfit *float64 `json:"monthly_profit"`
+}
+
+type safePercentageBody struct {
+	SafePercentage *float64 `json:"safe_percentage"`
+}
+
+func (s *server) listProducts(w http.ResponseWriter, r *http.Request) {
+	month := r.URL.Query().Get("month")
+	s.view(w, func(list product.ProductList) (any, error) {
+		if month == "" {
+			return nonNil(list.Products), nil
+		}
+
+		year, m, err := clock.ParseMonth(month)
+		if err != nil {
+			return nil, fmt.Errorf("%w: month=%q, use o formato AAAA-MM", errInvalidQuery, month)
+		}
+		return nonNil(report.ActiveProducts(list, year, m)), nil
+	})
+}
+
+func (s *server) getProduct(w http.ResponseWriter, r *http.Request) {
+	id, err := productID(r)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	s.view(w, func(list product.ProductList) (any, error) {
+		idx, ok := list.FindByID(id)
+		if !ok {
+			return nil, product.ErrProductNotFound
+		}
+		return list.Products[idx], nil
+	})
+}
+
+func (s *server) createProduct(w http.ResponseWriter, r *http.Request) {
+	var req productRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	now := s.opts.Clock.Now()
+	p, err := newProduct(req, now)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	s.update(w, func(list *product.ProductList) (int, any, error) {
+		added, err := list.Add(p, clock.RealNow(s.opts.Clock))
+		if err != nil {
+			return 0, nil, err
+		}
+		list.Month = int(now.Month())
+		list.Year = now.Year()
+
+		w.Header().Set("Location", fmt.Sprintf("/api/products/%d", added.ID))
+		return http.StatusCreated, added, nil
+	})
+}
+
+func (s *server) updateProduct(w http.ResponseWriter, r *http.Request) {
+	id, err := productID(r)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	var req productRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	if r.Method == http.MethodPut {
+		if req.Name == nil || req.Installments == nil || (req.TotalValue == nil && req.OriginalValue == nil) {
+			writeError(w, http.StatusBadRequest,
+				fmt.Errorf("%w: PUT substitui o produto; name, installments e total_value (ou original_value) são obrigatórios", ErrInvalidBody))
+			return
+		}
+		empty := ""
+		if req.Category == nil {
+			req.Category = &empty
+		}
+		if req.Card == nil {
+			req.Card = &empty
+		}
+	}
+	if req.WithIOF {
+		writeError(w, statusFor(errCurrencyChange), errCurrencyChange)
+		return
+	}
+
+	s.update(w, func(list *product.ProductList) (int, any, error) {
+		idx, ok := list.FindByID(id)
+		if !ok {
+			return 0, nil, product.ErrProductNotFound
+		}
+
+		p, err := editProduct(list.Products[idx], req)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := list.Update(p, clock.RealNow(s.opts.Clock)); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, p, nil
+	})
+}
+
+func (s *server) deleteProduct(w http.ResponseWriter, r *http.Request) {
+	id, err := productID(r)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	s.update(w, func(list *product.ProductList) (int, any, error) {
+		if _, ok := list.FindByID(id); !ok {
+			return 0, nil, product.ErrProductNotFound
+		}
+		return http.StatusNoContent, nil, list.Remove(id, clock.RealNow(s.opts.Clock))
+	})
+}
+
+func (s *server) getIncome(w http.ResponseWriter, r *http.Request) {
+	s.view(w, func(list product.ProductList) (any, error) {
+		return incomeBody{MonthlyProfit: &list.MonthlyProfit}, nil
+	})
+}
+
+func (s *server) setIncome(w http.ResponseWriter, r *http.Request) {
+	var req incomeBody
+	if err := decode(r, &req); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	if req.MonthlyProfit == nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("%w: monthly_profit é obrigatório", ErrInvalidBody))
+		return
+	}
+	if err := product.ValidateMonthlyProfit(*req.MonthlyProfit); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	s.update(w, func(list *product.ProductList) (int, any, error) {
+		now := s.opts.Clock.Now()
+		if err := list.SetMonthlyProfit(*req.MonthlyProfit, clock.RealNow(s.opts.Clock)); err != nil {
+			return 0, nil, err
+		}
+		list.Month = int(now.Month())
+		list.Year = now.Year()
+		return http.StatusOK, incomeBody{MonthlyProfit: &list.MonthlyProfit}, nil
+	})
+}
+
+func (s *server) getSafePercentage(w http.ResponseWriter, r *http.Request) {
+	s.view(w, func(list product.ProductList) (any, error) {
+		return safePercentageBody{SafePercentage: &list.SafePercentage}, nil
+	})
+}
+
+func (s *server) setSafePercentage(w http.ResponseWriter, r *http.Request) {
+	var req safePercentageBody
+	if err := decode(r, &req); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	if req.SafePercentage == nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("%w: safe_percentage é obrigatório", ErrInvalidBody))
+		return
+	}
+	if err := product.ValidateSafePercentage(*req.SafePercentage); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	s.update(w, func(list *product.ProductList) (int, any, error) {
+		if err := list.SetSafePercentage(*req.SafePercentage, clock.RealNow(s.opts.Clock)); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, safePercentageBody{SafePercentage: &list.SafePercentage}, nil
+	})
+}
+
+func (s *server) summary(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	now := s.opts.Clock.Now()
+	year, month := now.Year(), int(now.Month())
+	if value := query.Get("month"); value != "" {
+		var err error
+		if year, month, err = clock.ParseMonth(value); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("%w: month=%q, use o formato AAAA-MM", errInvalidQuery, value))
+			return
+		}
+	}
+
+	months := 0
+	if value := query.Get("months"); value != "" {
+		var err error
+		if months, err = strconv.Atoi(value); err != nil || months < 1 || months > maxSummaryMonths {
+			writeError(w, http.StatusBadRequest,
+				fmt.Errorf("%w: months=%q, use um número de 1 a %d", errInvalidQuery, value, maxSummaryMonths))
+			return
+		}
+	}
+
+	s.view(w, func(list product.ProductList) (any, error) {
+		if months == 0 {
+			return Summaries(list, year, month, 1)[0], nil
+		}
+		return Summaries(list, year, month, months), nil
+	})
+}
+
+func Summaries(list product.ProductList, year, month, months int) []report.MonthSummary {
+	summaries := make([]report.MonthSummary, 0, months)
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
+	for i := 0; i < months; i++ {
+		date := start.AddDate(0, i, 0)
+		summary := report.Summarize(list, date.Year(), int(date.Month()))
+		summary.Products = nonNil(summary.Products)
+		summary.Suggested = nonNil(summary.Suggested)
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func newProduct(req productRequest, now time.Time) (product.Product, error) {
+	if req.Name == nil || (req.TotalValue == nil && req.OriginalValue == nil) {
+		return product.Product{}, fmt.Errorf("%w: name e total_value (ou original_value) são obrigatórios", ErrInvalidBody)
+	}
+	installments := 1
+	if req.Installments != nil {
+		installments = *req.Installments
+	}
+	amount := req.TotalValue
+	if amount == nil {
+		amount = req.OriginalValue
+	}
+
+	p, err := product.New(strings.TrimSpace(*req.Name), *amount, installments, now)
+	if err != nil {
+		return p, err
+	}
+	if req.Category != nil {
+		p.Category = strings.TrimSpace(*req.Category)
+	}
+	if req.Card != nil {
+		p.Card = strings.TrimSpace(*req.Card)
+	}
+
+	if req.Currency == nil {
+		return p, setAmount(&p, req)
+	}
+	currency, err := money.NormalizeCurrency(*req.Currency)
+	if err != nil {
+		return p, err
+	}
+	if currency == money.BaseCurrency {
+		return p, setAmount(&p, req)
+	}
+
+	rates, err := storage.LoadRates()
+	if err != nil {
+		return p, err
+	}
+	rate, ok := rates.Rate(currency)
+	if req.ExchangeRate != nil {
+		rate = *req.ExchangeRate
+	} else if !ok {
+		return p, fmt.Errorf("%w: %s", money.ErrUnknownRate, currency)
+	}
+	iof := 0.0
+	if req.WithIOF {
+		iof = rates.IOF
+	}
+
+	if err := p.SetForeign(currency, *amount, rate, iof); err != nil {
+		return p, err
+	}
+	return p, setAmount(&p, req)
+}
+
+func editProduct(p product.Product, req productRequest) (product.Product, error) {
+	if currencyChanged(p, req) {
+		return p, errCurrencyChange
+	}
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if err := product.ValidateName(name); err != nil {
+			return p, err
+		}
+		p.Name = name
+	}
+	if req.Installments != nil {
+		if *req.Installments < 1 {
+			return p, product.ErrInvalidInstallments
+		}
+		p.Installments = *req.Installments
+	}
+	if err := setAmount(&p, req); err != nil {
+		return p, err
+	}
+	if req.Category != nil {
+		p.Category = strings.TrimSpace(*req.Category)
+	}
+	if req.Card != nil {
+		p.Card = strings.TrimSpace(*req.Card)
+	}
+	p.Parcel = p.TotalValue / float64(p.Installments)
+	return p, nil
+}
+
+func setAmount(p *product.Product, req productRequest) error {
+	switch {
+	case req.OriginalValue != nil:
+		if !p.IsForeign() {
+			return fmt.Errorf("%w: original_value só vale para produtos em outra moeda", ErrInvalidBody)
+		}
+		if *req.OriginalValue <= 0 {
+			return product.ErrInvalidValue
+		}
+		p.SetAmount(*req.OriginalValue)
+		if req.TotalValue != nil && math.Abs(*req.TotalValue-p.TotalValue) >= 0.005 {
+			return fmt.Errorf("%w: total_value não confere com original_value convertido", ErrInvalidBody)
+		}
+	case req.TotalValue != nil:
+		if *req.TotalValue <= 0 {
+			return product.ErrInvalidValue
+		}
+		p.SetTotalValue(*req.TotalValue)
+	}
+	return nil
+}
+
+func currencyChanged(p product.Product, req productRequest) bool {
+	if req.Currency != nil {
+		currency, err := money.NormalizeCurrency(*req.Currency)
+		if err != nil {
+			return true
+		}
+		if (currency == money.BaseCurrency) == p.IsForeign() || p.IsForeign() && currency != p.Currency {
+			return true
+		}
+	}
+	return req.ExchangeRate != nil && *req.ExchangeRate != p.ExchangeRate
+}
+
+func productID(r *http.Request) (int, error) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id < 1 {
+		return 0, fmt.Errorf("%w: id=%q", errInvalidQuery, r.PathValue("id"))
+	}
+	return id, nil
+}
+
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
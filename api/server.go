@@ -0,0 +1,227 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+const maxBodyBytes = 1 << 20
+
+var ErrInvalidBody = errors.New("corpo da requisição inválido")
+var ErrUnsupportedMediaType = errors.New("o corpo da requisição deve ser application/json")
+var ErrForbiddenOrigin = errors.New("origem não permitida")
+var ErrForbiddenHost = errors.New("host não permitido sem token de acesso")
+
+type Options struct {
+	Clock       clock.Clock
+	Load        func() (product.ProductList, error)
+	Save        func(*product.ProductList) error
+	Token       string
+	AllowOrigin string
+}
+
+type server struct {
+	opts Options
+	mu   sync.Mutex
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func New(opts Options) http.Handler {
+	if opts.Clock == nil {
+		opts.Clock = clock.System{}
+	}
+	s := &server{opts: opts}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/products", s.listProducts)
+	mux.HandleFunc("POST /api/products", s.createProduct)
+	mux.HandleFunc("GET /api/products/{id}", s.getProduct)
+	mux.HandleFunc("PUT /api/products/{id}", s.updateProduct)
+	mux.HandleFunc("PATCH /api/products/{id}", s.updateProduct)
+	mux.HandleFunc("DELETE /api/products/{id}", s.deleteProduct)
+	mux.HandleFunc("GET /api/income", s.getIncome)
+	mux.HandleFunc("PUT /api/income", s.setIncome)
+	mux.HandleFunc("GET /api/safe-percentage", s.getSafePercentage)
+	mux.HandleFunc("PUT /api/safe-percentage", s.setSafePercentage)
+	mux.HandleFunc("GET /api/summary", s.summary)
+
+	return s.middleware(mux)
+}
+
+func (s *server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.opts.Token == "" && !loopbackHost(r.Host) {
+			writeError(w, http.StatusForbidden, ErrForbiddenHost)
+			return
+		}
+		if !s.allowedOrigin(r) {
+			writeError(w, http.StatusForbidden, ErrForbiddenOrigin)
+			return
+		}
+
+		if s.opts.AllowOrigin != "" {
+			w.Header().Set("Access-Control-Allow-Origin", s.opts.AllowOrigin)
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+
+		if s.opts.Token != "" && strings.HasPrefix(r.URL.Path, "/api/") && !Authorized(r, s.opts.Token) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("token de acesso inválido"))
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func Authorized(r *http.Request, token string) bool {
+	value, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1
+}
+
+func (s *server) allowedOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || (s.opts.AllowOrigin != "" && origin == s.opts.AllowOrigin) {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host == r.Host
+}
+
+func IsLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func loopbackHost(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	return IsLoopback(strings.Trim(host, "[]"))
+}
+
+func (s *server) update(w http.ResponseWriter, change func(list *product.ProductList) (int, any, error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list, err := s.opts.Load()
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	status, body, err := change(&list)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	if err := s.opts.Save(&list); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	writeJSON(w, status, body)
+}
+
+func (s *server) view(w http.ResponseWriter, read func(list product.ProductList) (any, error)) {
+	s.mu.Lock()
+	list, err := s.opts.Load()
+	s.mu.Unlock()
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	body, err := read(list)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+func decode(r *http.Request, v any) error {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		return ErrUnsupportedMediaType
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBody, err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: conteúdo após o JSON", ErrInvalidBody)
+	}
+	return nil
+}
+
+func statusFor(err error) int {
+	var maxBytes *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytes):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, ErrInvalidBody), errors.Is(err, errInvalidQuery):
+		return http.StatusBadRequest
+	case errors.Is(err, product.ErrProductNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, storage.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, product.ErrInvalidName),
+		errors.Is(err, product.ErrInvalidValue),
+		errors.Is(err, product.ErrInvalidInstallments),
+		errors.Is(err, product.ErrInvalidPercentage),
+		errors.Is(err, errCurrencyChange),
+		errors.Is(err, money.ErrInvalidCurrency),
+		errors.Is(err, money.ErrInvalidRate),
+		errors.Is(err, money.ErrUnknownRate),
+		errors.Is(err, money.ErrInvalidIOF):
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
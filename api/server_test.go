@@ -0,0 +1,103 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+const productBody = `{"name": "Notebook", "total_value": 300, "installments": 3}`
+
+func testServer(token, allowOrigin string) (http.Handler, *product.ProductList) {
+	list := &product.ProductList{SafePercentage: 70}
+	handler := New(Options{
+		Clock: clock.Fixed{Time: time.Date(2026, time.March, 15, 12, 0, 0, 0, time.Local)},
+		Load: func() (product.ProductList, error) {
+			return *list, nil
+		},
+		Save: func(saved *product.ProductList) error {
+			*list = *saved
+			return nil
+		},
+		Token:       token,
+		AllowOrigin: allowOrigin,
+	})
+	return handler, list
+}
+
+func TestRequestChecks(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       string
+		allowOrigin string
+		host        string
+		method      string
+		contentType string
+		origin      string
+		auth        string
+		want        int
+	}{
+		{name: "json em localhost", host: "localhost:8080", method: "POST", contentType: "application/json", want: http.StatusCreated},
+		{name: "json com charset", host: "127.0.0.1:8080", method: "POST", contentType: "application/json; charset=utf-8", want: http.StatusCreated},
+		{name: "text/plain", host: "localhost:8080", method: "POST", contentType: "text/plain", want: http.StatusUnsupportedMediaType},
+		{name: "formulário", host: "localhost:8080", method: "POST", contentType: "application/x-www-form-urlencoded", want: http.StatusUnsupportedMediaType},
+		{name: "sem content-type", host: "localhost:8080", method: "POST", want: http.StatusUnsupportedMediaType},
+		{name: "mesma origem", host: "localhost:8080", method: "POST", contentType: "application/json", origin: "http://localhost:8080", want: http.StatusCreated},
+		{name: "outra origem", host: "localhost:8080", method: "POST", contentType: "application/json", origin: "http://evil.example", want: http.StatusForbidden},
+		{name: "outra origem na leitura", host: "localhost:8080", method: "GET", origin: "http://evil.example", want: http.StatusForbidden},
+		{name: "origem null", host: "localhost:8080", method: "POST", contentType: "application/json", origin: "null", want: http.StatusForbidden},
+		{name: "origem liberada", allowOrigin: "http://192.168.0.10:3000", host: "localhost:8080", method: "POST", contentType: "application/json", origin: "http://192.168.0.10:3000", want: http.StatusCreated},
+		{name: "host de outro site sem token", host: "evil.example", method: "GET", want: http.StatusForbidden},
+		{name: "host de outro site com porta", host: "evil.example:8080", method: "GET", want: http.StatusForbidden},
+		{name: "host ipv6 de loopback", host: "[::1]:8080", method: "GET", want: http.StatusOK},
+		{name: "host da rede com token", token: "segredo", host: "192.168.0.5:8080", method: "GET", auth: "Bearer segredo", want: http.StatusOK},
+		{name: "token errado", token: "segredo", host: "192.168.0.5:8080", method: "GET", auth: "Bearer outro", want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, _ := testServer(tt.token, tt.allowOrigin)
+
+			body := strings.NewReader("")
+			if tt.method == "POST" {
+				body = strings.NewReader(productBody)
+			}
+			req := httptest.NewRequest(tt.method, "/api/products", body)
+			req.Host = tt.host
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, esperado %d: %s", rec.Code, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestRejectedRequestsDoNotSave(t *testing.T) {
+	handler, list := testServer("", "")
+
+	req := httptest.NewRequest("POST", "/api/products", strings.NewReader(productBody))
+	req.Host = "localhost:8080"
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Origin", "http://evil.example")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(list.Products) != 0 {
+		t.Errorf("requisição de outra origem salvou %d produto(s)", len(list.Products))
+	}
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pedrorcruzz/smart-spending-checker/alert"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+func runAlerts(e env, args []string) error {
+	fs := newFlagSet("alerts", &e.profile)
+	kind := fs.String("kind", "", "tipo da regra: used_percent, single_purchase ou card_products")
+	threshold := fs.String("threshold", "", "porcentagem do lucro ou máximo de produtos ativos")
+	months := fs.Int("months", 0, "meses a verificar (used_percent)")
+	card := fs.String("card", "", "cartão (card_products)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+	rules, err := storage.LoadRules(profile)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(positional) == 0:
+		alerts := alert.Evaluate(list, rules, e.clock.Now())
+		for _, a := range alerts {
+			fmt.Println("🔔 " + a.Message)
+		}
+		if len(alerts) == 0 {
+			fmt.Println("Nenhum alerta.")
+		}
+		return nil
+	case len(positional) == 1 && positional[0] == "rules":
+		if len(rules) == 0 {
+			fmt.Println("Nenhuma regra de alerta cadastrada.")
+		}
+		for i, rule := range rules {
+			fmt.Printf("%d. %s\n", i+1, rule.Describe())
+		}
+		return nil
+	case len(positional) == 1 && positional[0] == "add":
+		rule := alert.Rule{Months: *months, Card: *card}
+		var ok bool
+		if rule.Kind, ok = alert.ParseKind(*kind); !ok {
+			return invalid("%v: %q", alert.ErrInvalidKind, *kind)
+		}
+		if rule.Threshold, err = money.Parse(*threshold); err != nil {
+			return invalid("limite inválido: %q", *threshold)
+		}
+		if err := rule.Validate(); err != nil {
+			return usageError{err}
+		}
+		rules = append(rules, rule)
+	case len(positional) == 2 && positional[0] == "remove":
+		number, err := strconv.Atoi(positional[1])
+		if err != nil || number < 1 || number > len(rules) {
+			return invalid("regra não encontrada: %s", positional[1])
+		}
+		rules = append(rules[:number-1], rules[number:]...)
+	default:
+		return invalid("uso: alerts [rules | add --kind TIPO --threshold VALOR [--months N] [--card CARTÃO] | remove N]")
+	}
+
+	if err := storage.SaveRules(profile, rules); err != nil {
+		return err
+	}
+
+	fmt.Println("✅ Alertas atualizados!")
+	return nil
+}
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/api"
+	"github.com/pedrorcruzz/smart-spending-checker/ics"
+)
+
+type dueDaysFlag struct {
+	opts *ics.Options
+}
+
+func (f dueDaysFlag) String() string {
+	return ""
+}
+
+func (f dueDaysFlag) Set(value string) error {
+	card, dayText, found := strings.Cut(value, "=")
+	if !found {
+		card, dayText = "", value
+	}
+
+	day, err := strconv.Atoi(strings.TrimSpace(dayText))
+	if err != nil || day < 1 || day > 31 {
+		return fmt.Errorf("dia de vencimento inválido %q: use um número de 1 a 31", dayText)
+	}
+
+	card = strings.TrimSpace(card)
+	if card == "" {
+		f.opts.DueDay = day
+		return nil
+	}
+	if f.opts.DueDays == nil {
+		f.opts.DueDays = make(map[string]int)
+	}
+	f.opts.DueDays[card] = day
+	return nil
+}
+
+func runCalendar(e env, args []string) error {
+	opts := ics.Options{AlarmDays: 2}
+	fs := newFlagSet("calendar", &e.profile)
+	file := fs.String("file", "", "arquivo .ics (padrão: saída padrão)")
+	mode := fs.String("mode", string(ics.ModeInstallments), "parcelas ou faturas")
+	fs.IntVar(&opts.Months, "months", ics.DefaultMonths, "quantidade de meses a partir de hoje")
+	fs.Var(dueDaysFlag{&opts}, "due-day", "dia de vencimento das faturas (DIA ou CARTÃO=DIA)")
+	fs.IntVar(&opts.AlarmDays, "alarm", opts.AlarmDays, "dias de antecedência do lembrete (0 desativa)")
+	serve := fs.String("serve", "", "endereço para servir o calendário, como :8080 (só este computador) ou 0.0.0.0:8080 (toda a rede)")
+	token := fs.String("token", os.Getenv(tokenEnv), "token exigido com --serve na URL (?token=) ou no cabeçalho Authorization: Bearer")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var ok bool
+	if opts.Mode, ok = ics.ParseMode(*mode); !ok {
+		return invalid("modo inválido %q: use parcelas ou faturas", *mode)
+	}
+	if opts.Months < 1 || opts.Months > 120 {
+		return invalid("quantidade de meses inválida: %d", opts.Months)
+	}
+	if opts.AlarmDays < 0 {
+		return invalid("antecedência do lembrete inválida: %d", opts.AlarmDays)
+	}
+
+	if *serve != "" {
+		return serveCalendar(e, opts, *serve, *token)
+	}
+
+	_, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+	opts.From = e.clock.Now()
+	opts.Now = opts.From
+
+	if *file == "" {
+		return ics.Export(os.Stdout, list, opts)
+	}
+
+	f, err := os.Create(*file)
+	if err != nil {
+		return err
+	}
+	err = ics.Export(f, list, opts)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ Calendário exportado para %s\n", *file)
+	return nil
+}
+
+func serveCalendar(e env, opts ics.Options, addr, token string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return invalid("endereço inválido %q: use HOST:PORTA, como :8080", addr)
+	}
+	if host == "" {
+		addr = net.JoinHostPort("127.0.0.1", port)
+	} else if token == "" && !api.IsLoopback(host) {
+		return invalid("para servir o calendário na rede use --token ou %s", tokenEnv)
+	}
+
+	if _, _, err := openList(e.profile); err != nil {
+		return err
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if token != "" && !calendarAuthorized(r, token) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "token de acesso inválido", http.StatusUnauthorized)
+			return
+		}
+
+		_, list, err := openList(e.profile)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		opts := opts
+		opts.From = e.clock.Now()
+		opts.Now = opts.From
+
+		var buf bytes.Buffer
+		if err := ics.Export(&buf, list, opts); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", `inline; filename="gastos.ics"`)
+		w.Write(buf.Bytes())
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", handler)
+	mux.HandleFunc("GET /gastos.ics", handler)
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	if token != "" {
+		fmt.Printf("Servindo o calendário em http://%s/gastos.ics?token=TOKEN (Ctrl+C para sair)\n", displayAddr(addr))
+	} else {
+		fmt.Printf("Servindo o calendário em http://%s/gastos.ics (Ctrl+C para sair)\n", displayAddr(addr))
+	}
+	return server.ListenAndServe()
+}
+
+func calendarAuthorized(r *http.Request, token string) bool {
+	if api.Authorized(r, token) {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(token)) == 1
+}
+
+func displayAddr(addr string) string {
+	if strings.HasPrefix(addr, ":") {
+		return "localhost" + addr
+	}
+	return addr
+}
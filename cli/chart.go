@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/pedrorcruzz/smart-spending-checker/menu"
+)
+
+func runChart(e env, args []string) error {
+	fs := newFlagSet("chart", &e.profile)
+	month := fs.String("month", "", "primeiro mês no formato AAAA-MM")
+	months := fs.Int("months", 12, "quantidade de meses (12 a 24)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *months < 12 || *months > 24 {
+		return invalid("quantidade de meses inválida: %d (use de 12 a 24)", *months)
+	}
+
+	year, m, err := parseMonth(e.clock, *month)
+	if err != nil {
+		return err
+	}
+
+	_, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	menu.ShowChart(os.Stdout, list, year, m, *months)
+	return nil
+}
@@ -0,0 +1,362 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/menu"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+const passphraseEnv = "SSC_PASSPHRASE"
+
+const usage = `Uso: smart-spending-checker [--profile NOME] [--as-of AAAA-MM] [--lang IDIOMA] [comando] [opções]
+
+Sem comando, abre o menu interativo.
+Com --as-of, o programa considera que hoje é um dia do mês informado: produtos
+adicionados ficam com a data de compra nesse mês, mas o histórico de alterações
+registra sempre a data real.
+Com --lang (ou a variável ` + i18n.Env + `), escolhe o idioma do menu e da tela cheia:
+pt-BR (padrão), en-US ou es.
+
+Comandos:
+  add --name NOME --total VALOR --installments N [--category CATEGORIA] [--card CARTÃO]
+      [--currency MOEDA [--rate COTAÇÃO] [--iof]]
+        Adiciona um produto parcelado. Com --currency o valor está na moeda
+        informada e é convertido para reais pela cotação da tabela de câmbio
+        (ou por --rate); --iof soma o IOF da tabela
+  remove --id ID
+        Remove um produto
+  edit --id ID [--name NOME] [--total VALOR] [--installments N] [--category CATEGORIA]
+      [--card CARTÃO]
+        Edita um produto
+  list [--month AAAA-MM] [--format text|json|csv]
+        Lista os produtos ativos no mês (padrão: mês atual)
+  summary [--month AAAA-MM] [--months N] [--person NOME] [--format text|json|csv]
+        Mostra o resumo do mês (padrão: mês atual). Com --person, conta só a
+        parte da pessoa nas compras divididas, contra a renda dela
+  chart [--month AAAA-MM] [--months N]
+        Mostra um gráfico de barras das parcelas dos próximos 12 a 24 meses
+        com o limite da porcentagem segura; meses acima do limite ficam
+        destacados
+  alerts [rules | add --kind TIPO --threshold VALOR [--months N] [--card CARTÃO]
+      | remove N]
+        Sem argumentos, avalia as regras de alerta do perfil. Tipos:
+        used_percent (uso acima de VALOR% em algum dos próximos N meses),
+        single_purchase (compra acima de VALOR% do lucro mensal) e
+        card_products (mais de VALOR produtos ativos no cartão)
+  notify [--days N] [--command COMANDO] [--webhook URL] [--dry-run]
+        Avisa das parcelas que vencem nos próximos N dias (padrão: 3) e dos
+        alertas do perfil, para uso no cron. Envia pelo comando, pelo webhook
+        e pelo e-mail (SMTP) configurados em data/notify.json; --dry-run só
+        mostra a mensagem
+  people [set NOME RENDA | remove NOME | owner NOME]
+        Mostra ou altera as pessoas que dividem compras e a renda mensal de
+        cada uma. O titular do perfil ("Eu" até ser renomeado com owner) tem
+        o lucro mensal como renda
+  split --id ID [--paid-by NOME] PESSOA=PARTE... | --clear
+        Divide um produto: PARTE é uma porcentagem (Ana=50%) ou um valor do
+        total (Ana=300,00); o que sobrar fica com quem pagou (padrão: o titular)
+  settle [--month AAAA-MM] [--months N] [--format text|json]
+        Mostra quem deve a quem pelas compras divididas no período
+  profit set VALOR
+        Define o lucro mensal
+  safe set PORCENTAGEM
+        Define a porcentagem segura
+  import --file ARQUIVO [--dry-run]
+        Importa produtos de um CSV com cabeçalho
+        name,total,installments,purchase_date,category,card. Linhas inválidas
+        e produtos já cadastrados são ignorados; --dry-run só mostra a prévia
+  export [--file ARQUIVO]
+        Exporta os produtos em CSV no mesmo formato do import
+  ofx --file ARQUIVO [--card CARTÃO] [--dry-run]
+        Importa um extrato OFX do cartão: concilia lançamentos como
+        "LOJA X 03/10" com os produtos, marca a parcela como paga e cria
+        produtos para parcelamentos desconhecidos marcados com PARC,
+        PARCELA ou DE ("LOJA X PARC 03/10")
+  ledger [--file ARQUIVO] [--from AAAA-MM] [--to AAAA-MM] [--expenses CONTA]
+      [--liabilities CONTA] [--income CONTA] [--assets CONTA]
+        Exporta as parcelas e o lucro mensal como diário do hledger/ledger
+  calendar [--file ARQUIVO] [--mode parcelas|faturas] [--months N]
+      [--due-day DIA|CARTÃO=DIA] [--alarm DIAS] [--serve ENDEREÇO] [--token TOKEN]
+        Gera um calendário .ics com um evento por parcela a vencer ou, com
+        --mode faturas, um por vencimento de fatura de cada cartão (padrão:
+        dia 10). Com --serve, serve o calendário atualizado em
+        http://ENDEREÇO/gastos.ics para assinatura; sem host no endereço,
+        só para este computador, e na rede apenas com --token
+  serve [--addr ENDEREÇO] [--token TOKEN] [--allow-origin ORIGEM]
+      [--no-dashboard]
+        Serve um painel web em http://ENDEREÇO/ e uma API REST em
+        http://ENDEREÇO/api (padrão: localhost:8080) com os produtos, o
+        lucro mensal, a porcentagem segura e os resumos do perfil. Sem host
+        no endereço, só para este computador; na rede, como 0.0.0.0:8080,
+        exige --token (ou a variável ` + tokenEnv + `), e as requisições
+        precisam do cabeçalho Authorization: Bearer TOKEN
+  rates [set MOEDA COTAÇÃO | remove MOEDA | iof PORCENTAGEM]
+        Mostra ou altera a tabela de câmbio (data/rates.json)
+  tui
+        Abre a interface em tela cheia (linha do tempo de meses, tabela de
+        produtos e resumo do mês, navegada com as setas)
+
+Todos os comandos e o menu aceitam --profile NOME para escolher o perfil, sem
+trocar o perfil ativo.
+Perfis criptografados leem a senha da variável de ambiente ` + passphraseEnv + `.
+
+Códigos de saída: 0 sucesso, 1 erro ao executar, 2 uso ou valor inválido.
+`
+
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+func invalid(format string, args ...any) error {
+	return usageError{fmt.Errorf(format, args...)}
+}
+
+type env struct {
+	profile string
+	clock   clock.Clock
+}
+
+type command func(e env, args []string) error
+
+var commands = map[string]command{
+	"add":      runAdd,
+	"remove":   runRemove,
+	"edit":     runEdit,
+	"list":     runList,
+	"summary":  runSummary,
+	"chart":    runChart,
+	"alerts":   runAlerts,
+	"notify":   runNotify,
+	"people":   runPeople,
+	"split":    runSplit,
+	"settle":   runSettle,
+	"profit":   runProfit,
+	"safe":     runSafe,
+	"tui":      runTUI,
+	"rates":    runRates,
+	"import":   runImport,
+	"export":   runExport,
+	"ofx":      runOFX,
+	"ledger":   runLedger,
+	"calendar": runCalendar,
+	"serve":    runServe,
+}
+
+func Run(args []string) int {
+	global := flag.NewFlagSet("smart-spending-checker", flag.ContinueOnError)
+	global.SetOutput(io.Discard)
+	profile := global.String("profile", "", "perfil a usar")
+	asOf := global.String("as-of", "", "data de referência no formato AAAA-MM")
+	lang := global.String("lang", "", "idioma da interface: pt-BR, en-US ou es")
+
+	if err := global.Parse(args); errors.Is(err, flag.ErrHelp) {
+		fmt.Print(usage)
+		return exitOK
+	} else if err != nil {
+		return fail(usageError{err})
+	}
+
+	if err := setLocale(*lang); err != nil {
+		return fail(invalid("%v", err))
+	}
+
+	e := env{profile: *profile, clock: clock.System{}}
+	if *asOf != "" {
+		year, month, err := clock.ParseMonth(*asOf)
+		if err != nil {
+			return fail(invalid("data de referência inválida %q: use o formato AAAA-MM", *asOf))
+		}
+		e.clock = clock.AsOf(e.clock, year, month)
+	}
+
+	rest := global.Args()
+	if len(rest) == 0 {
+		if e.profile != "" && !storage.ProfileExists(e.profile) {
+			return fail(invalid("perfil não encontrado: %s", e.profile))
+		}
+		menu.ShowMenu(menu.Options{Clock: e.clock, Profile: e.profile})
+		return exitOK
+	}
+
+	name := rest[0]
+	if name == "help" || name == "ajuda" {
+		fmt.Print(usage)
+		return exitOK
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		return fail(invalid("comando desconhecido: %s", name))
+	}
+
+	return fail(cmd(e, rest[1:]))
+}
+
+func setLocale(flagValue string) error {
+	locale := flagValue
+	if locale == "" {
+		locale = os.Getenv(i18n.Env)
+	}
+	if locale == "" {
+		config, _ := storage.LoadConfig()
+		locale = config.Language
+	}
+	if locale == "" {
+		return nil
+	}
+	return i18n.SetLocale(locale)
+}
+
+func fail(err error) int {
+	if err == nil {
+		return exitOK
+	}
+
+	fmt.Fprintln(os.Stderr, "erro:", err)
+
+	var ue usageError
+	if errors.As(err, &ue) {
+		if errors.Is(ue.err, flag.ErrHelp) {
+			fmt.Fprint(os.Stderr, "\n"+usage)
+		}
+		return exitUsage
+	}
+	return exitError
+}
+
+func newFlagSet(name string, profile *string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(profile, "profile", *profile, "perfil a usar")
+	return fs
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return invalid("argumento inesperado: %s", positional[0])
+	}
+	return nil
+}
+
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, usageError{err}
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func setFlags(fs *flag.FlagSet) map[string]bool {
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	return set
+}
+
+func parseMonth(clk clock.Clock, value string) (int, int, error) {
+	if value == "" {
+		now := clk.Now()
+		return now.Year(), int(now.Month()), nil
+	}
+
+	year, month, err := clock.ParseMonth(value)
+	if err != nil {
+		return 0, 0, invalid("mês inválido %q: use o formato AAAA-MM", value)
+	}
+	return year, month, nil
+}
+
+func openList(profile string) (string, product.ProductList, error) {
+	var list product.ProductList
+
+	if profile == "" {
+		config, err := storage.LoadConfig()
+		if err != nil {
+			return profile, list, err
+		}
+		profile = config.ActiveProfile
+	}
+
+	if !storage.ProfileExists(profile) {
+		return profile, list, invalid("perfil não encontrado: %s", profile)
+	}
+
+	encrypted, err := storage.IsEncrypted(profile)
+	if err != nil {
+		return profile, list, err
+	}
+	if encrypted && !storage.IsUnlocked(profile) {
+		passphrase := os.Getenv(passphraseEnv)
+		if passphrase == "" {
+			return profile, list, fmt.Errorf("%w (defina %s)", storage.ErrPassphraseRequired, passphraseEnv)
+		}
+		if err := storage.Unlock(profile, passphrase); err != nil {
+			return profile, list, err
+		}
+	}
+
+	list, err = storage.LoadProducts(profile)
+	if err != nil {
+		return profile, list, err
+	}
+
+	if list.SafePercentage == 0 {
+		list.SafePercentage = 70
+	}
+	return profile, list, nil
+}
+
+func saveList(profile string, list *product.ProductList) error {
+	err := storage.SaveProducts(profile, list)
+	if !errors.Is(err, storage.ErrConflict) {
+		return err
+	}
+
+	pending := list.PendingEvents()
+	reloaded, err := storage.LoadProducts(profile)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) == 0 {
+		*list = reloaded
+		return nil
+	}
+	if skipped := reloaded.Merge(pending); skipped > 0 {
+		return fmt.Errorf("%w: não foi possível aplicar a alteração sobre os dados atuais", storage.ErrConflict)
+	}
+
+	*list = reloaded
+	return storage.SaveProducts(profile, list)
+}
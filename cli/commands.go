@@ -0,0 +1,458 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/menu"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+	"github.com/pedrorcruzz/smart-spending-checker/tui"
+	"github.com/pedrorcruzz/smart-spending-checker/utils"
+)
+
+func runAdd(e env, args []string) error {
+	fs := newFlagSet("add", &e.profile)
+	name := fs.String("name", "", "nome do produto")
+	total := fs.String("total", "", "valor total do produto")
+	installments := fs.Int("installments", 1, "número de parcelas")
+	category := fs.String("category", "", "categoria do produto")
+	card := fs.String("card", "", "cartão usado na compra")
+	currency := fs.String("currency", money.BaseCurrency, "moeda do valor total (ex.: USD)")
+	rate := fs.String("rate", "", "cotação da moeda em reais (padrão: tabela de câmbio)")
+	iof := fs.Bool("iof", false, "inclui o IOF da tabela de câmbio")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	totalValue, err := money.Parse(*total)
+	if err != nil {
+		return invalid("valor total inválido: %q", *total)
+	}
+
+	now := e.clock.Now()
+	p, err := product.New(*name, totalValue, *installments, now)
+	if err != nil {
+		return usageError{err}
+	}
+	p.Category = strings.TrimSpace(*category)
+	p.Card = strings.TrimSpace(*card)
+	if err := setCurrency(&p, *currency, totalValue, *rate, *iof); err != nil {
+		return err
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	p, err = list.Add(p, clock.RealNow(e.clock))
+	if err != nil {
+		return err
+	}
+	list.Month = int(now.Month())
+	list.Year = now.Year()
+
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Produto adicionado (id %d)! Parcela mensal: %s\n", p.ID, money.Format(p.Parcel))
+	return nil
+}
+
+func runRemove(e env, args []string) error {
+	fs := newFlagSet("remove", &e.profile)
+	id := fs.Int("id", 0, "id do produto")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	idx, ok := list.FindByID(*id)
+	if !ok {
+		return invalid("%v: id %d", product.ErrProductNotFound, *id)
+	}
+	name := list.Products[idx].Name
+
+	if err := list.Remove(*id, clock.RealNow(e.clock)); err != nil {
+		return err
+	}
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Produto '%s' removido!\n", name)
+	return nil
+}
+
+func runEdit(e env, args []string) error {
+	fs := newFlagSet("edit", &e.profile)
+	id := fs.Int("id", 0, "id do produto")
+	name := fs.String("name", "", "novo nome")
+	total := fs.String("total", "", "novo valor total")
+	installments := fs.Int("installments", 0, "novo número de parcelas")
+	category := fs.String("category", "", "nova categoria (vazia para remover)")
+	card := fs.String("card", "", "novo cartão (vazio para remover)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	set := setFlags(fs)
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	idx, ok := list.FindByID(*id)
+	if !ok {
+		return invalid("%v: id %d", product.ErrProductNotFound, *id)
+	}
+	p := list.Products[idx]
+
+	if set["name"] {
+		if err := product.ValidateName(*name); err != nil {
+			return usageError{err}
+		}
+		p.Name = *name
+	}
+	if set["total"] {
+		totalValue, err := money.Parse(*total)
+		if err != nil || totalValue <= 0 {
+			return invalid("valor total inválido: %q", *total)
+		}
+		p.SetAmount(totalValue)
+	}
+	if set["installments"] {
+		if *installments < 1 {
+			return usageError{product.ErrInvalidInstallments}
+		}
+		p.Installments = *installments
+	}
+	if set["category"] {
+		p.Category = strings.TrimSpace(*category)
+	}
+	if set["card"] {
+		p.Card = strings.TrimSpace(*card)
+	}
+	p.Parcel = p.TotalValue / float64(p.Installments)
+
+	if err := list.Update(p, clock.RealNow(e.clock)); err != nil {
+		return err
+	}
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+
+	fmt.Println("✅ Produto atualizado!")
+	return nil
+}
+
+func runList(e env, args []string) error {
+	fs := newFlagSet("list", &e.profile)
+	month := fs.String("month", "", "mês no formato AAAA-MM")
+	format := fs.String("format", report.FormatText, "formato de saída: text, json ou csv")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if !report.ValidFormat(*format) {
+		return invalid("formato inválido: %s", *format)
+	}
+
+	year, m, err := parseMonth(e.clock, *month)
+	if err != nil {
+		return err
+	}
+
+	_, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	lines := report.ActiveProducts(list, year, m)
+	switch *format {
+	case report.FormatJSON:
+		return report.WriteJSON(os.Stdout, lines)
+	case report.FormatCSV:
+		return report.WriteProductsCSV(os.Stdout, lines)
+	}
+
+	if len(lines) == 0 {
+		fmt.Printf("Nenhum produto ativo para %02d/%d.\n", m, year)
+		return nil
+	}
+
+	var totalParcel float64
+	for _, p := range lines {
+		fmt.Printf("%d | %s | Total: %s | Parcela: %s (%d/%d) | Adicionado em: %s\n",
+			p.ID, p.Name, totalText(p), money.Format(p.Parcel), p.InstallmentNumber, p.Installments,
+			p.CreatedAt.Format("02/01/2006"))
+		totalParcel += p.Parcel
+	}
+	fmt.Printf("Total de parcelas: %s\n", money.Format(totalParcel))
+	return nil
+}
+
+func runSummary(e env, args []string) error {
+	fs := newFlagSet("summary", &e.profile)
+	month := fs.String("month", "", "mês inicial no formato AAAA-MM")
+	months := fs.Int("months", 1, "quantidade de meses a partir do mês inicial")
+	person := fs.String("person", "", "pessoa cuja parte das compras divididas será resumida")
+	format := fs.String("format", report.FormatText, "formato de saída: text, json ou csv")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if !report.ValidFormat(*format) {
+		return invalid("formato inválido: %s", *format)
+	}
+	if *months < 1 {
+		return invalid("quantidade de meses inválida: %d", *months)
+	}
+
+	year, m, err := parseMonth(e.clock, *month)
+	if err != nil {
+		return err
+	}
+
+	_, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	summaries := make([]report.MonthSummary, 0, *months)
+	start := time.Date(year, time.Month(m), 1, 0, 0, 0, 0, time.Local)
+	for i := 0; i < *months; i++ {
+		date := start.AddDate(0, i, 0)
+		if *person == "" {
+			summaries = append(summaries, report.Summarize(list, date.Year(), int(date.Month())))
+			continue
+		}
+		summary, err := report.SummarizePerson(list, personInput(*person), date.Year(), int(date.Month()))
+		if err != nil {
+			return invalid("%w: %s", err, *person)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	switch *format {
+	case report.FormatJSON:
+		if len(summaries) == 1 {
+			return report.WriteJSON(os.Stdout, summaries[0])
+		}
+		return report.WriteJSON(os.Stdout, summaries)
+	case report.FormatCSV:
+		return report.WriteSummaryCSV(os.Stdout, summaries...)
+	}
+
+	for _, summary := range summaries {
+		if *person != "" {
+			summary.Person = personLabel(summary.Person)
+		}
+		menu.ShowSummary(os.Stdout, summary)
+	}
+	return nil
+}
+
+func runProfit(e env, args []string) error {
+	value, err := parseSetValue("profit", &e.profile, args)
+	if err != nil {
+		return err
+	}
+	if err := product.ValidateMonthlyProfit(value); err != nil {
+		return usageError{err}
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	now := e.clock.Now()
+	if err := list.SetMonthlyProfit(value, clock.RealNow(e.clock)); err != nil {
+		return err
+	}
+	list.Month = int(now.Month())
+	list.Year = now.Year()
+
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+
+	fmt.Println("✅ Lucro mensal atualizado!")
+	return nil
+}
+
+func runSafe(e env, args []string) error {
+	value, err := parseSetValue("safe", &e.profile, args)
+	if err != nil {
+		return err
+	}
+	if err := product.ValidateSafePercentage(value); err != nil {
+		return usageError{err}
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	if err := list.SetSafePercentage(value, clock.RealNow(e.clock)); err != nil {
+		return err
+	}
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Porcentagem segura atualizada para %s%%!\n", money.FormatPercent(value, 0))
+	return nil
+}
+
+func runTUI(e env, args []string) error {
+	fs := newFlagSet("tui", &e.profile)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	profile, list, err := openList(e.profile)
+	if errors.Is(err, storage.ErrPassphraseRequired) {
+		fmt.Printf("🔒 Senha do perfil '%s': ", profile)
+		passphrase := utils.ReadPassword(os.Stdin, bufio.NewReader(os.Stdin), os.Stdout)
+		if err := storage.Unlock(profile, passphrase); err != nil {
+			return err
+		}
+		profile, list, err = openList(profile)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tui.Run(tui.Options{
+		Profile: profile,
+		List:    list,
+		Clock:   e.clock,
+		Save: func(l *product.ProductList) error {
+			return saveList(profile, l)
+		},
+	})
+}
+
+func runRates(e env, args []string) error {
+	fs := newFlagSet("rates", &e.profile)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	rates, err := storage.LoadRates()
+	if err != nil {
+		return err
+	}
+
+	now := clock.RealNow(e.clock)
+	switch {
+	case len(positional) == 0:
+		for _, currency := range rates.Currencies() {
+			fmt.Printf("%s: %s\n", currency, money.Format(rates.Rates[currency]))
+		}
+		fmt.Printf("IOF: %s%%\n", money.FormatNumber(rates.IOF))
+		return nil
+	case len(positional) == 3 && positional[0] == "set":
+		rate, err := money.Parse(positional[2])
+		if err != nil {
+			return invalid("cotação inválida: %q", positional[2])
+		}
+		if err := rates.Set(positional[1], rate, now); err != nil {
+			return usageError{err}
+		}
+	case len(positional) == 2 && positional[0] == "remove":
+		if !rates.Remove(positional[1], now) {
+			return invalid("%v: %s", money.ErrUnknownRate, positional[1])
+		}
+	case len(positional) == 2 && positional[0] == "iof":
+		iof, err := money.Parse(positional[1])
+		if err != nil {
+			return invalid("IOF inválido: %q", positional[1])
+		}
+		if err := rates.SetIOF(iof, now); err != nil {
+			return usageError{err}
+		}
+	default:
+		return invalid("uso: rates [set MOEDA COTAÇÃO | remove MOEDA | iof PORCENTAGEM]")
+	}
+
+	if err := storage.SaveRates(rates); err != nil {
+		return err
+	}
+
+	fmt.Println("✅ Tabela de câmbio atualizada!")
+	return nil
+}
+
+func setCurrency(p *product.Product, currency string, amount float64, rateText string, withIOF bool) error {
+	code, err := money.NormalizeCurrency(currency)
+	if err != nil {
+		return usageError{err}
+	}
+	if code == money.BaseCurrency {
+		return nil
+	}
+
+	rates, err := storage.LoadRates()
+	if err != nil {
+		return err
+	}
+
+	rate, ok := rates.Rate(code)
+	if rateText != "" {
+		if rate, err = money.Parse(rateText); err != nil {
+			return invalid("cotação inválida: %q", rateText)
+		}
+	} else if !ok {
+		return invalid("%v: %s (use --rate)", money.ErrUnknownRate, code)
+	}
+
+	iof := 0.0
+	if withIOF {
+		iof = rates.IOF
+	}
+	if err := p.SetForeign(code, amount, rate, iof); err != nil {
+		return usageError{err}
+	}
+	return nil
+}
+
+func totalText(p report.ProductLine) string {
+	if p.Currency == "" {
+		return money.Format(p.TotalValue)
+	}
+	return money.FormatIn(p.OriginalValue, p.Currency) + " (" + money.Format(p.TotalValue) + ")"
+}
+
+func parseSetValue(name string, profile *string, args []string) (float64, error) {
+	fs := newFlagSet(name, profile)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(positional) != 2 || positional[0] != "set" {
+		return 0, invalid("uso: %s set VALOR", name)
+	}
+
+	value, err := money.Parse(positional[1])
+	if err != nil {
+		return 0, invalid("valor inválido: %q", positional[1])
+	}
+	return value, nil
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/csvio"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+)
+
+func runExport(e env, args []string) error {
+	fs := newFlagSet("export", &e.profile)
+	file := fs.String("file", "", "arquivo CSV de saída (padrão: saída padrão)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	_, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	if *file == "" {
+		return csvio.Export(os.Stdout, list.Products)
+	}
+
+	f, err := os.Create(*file)
+	if err != nil {
+		return err
+	}
+	if err := csvio.Export(f, list.Products); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ %d produto(s) exportado(s) para %s\n", len(list.Products), *file)
+	return nil
+}
+
+func runImport(e env, args []string) error {
+	fs := newFlagSet("import", &e.profile)
+	file := fs.String("file", "", "arquivo CSV a importar")
+	dryRun := fs.Bool("dry-run", false, "apenas mostra o que seria importado")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return invalid("uso: import --file ARQUIVO [--dry-run]")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	now := e.clock.Now()
+	rows, err := csvio.Read(f, list.Products, now)
+	if err != nil {
+		return usageError{err}
+	}
+
+	var valid, duplicates, invalidRows int
+	for _, row := range rows {
+		switch {
+		case row.Err != nil:
+			invalidRows++
+			fmt.Printf("linha %d: ❌ %v\n", row.Line, row.Err)
+		case row.Duplicate:
+			duplicates++
+			fmt.Printf("linha %d: ⚠️  duplicado: %s | %s | %dx | %s\n", row.Line, row.Product.Name,
+				money.Format(row.Product.TotalValue), row.Product.Installments, row.Product.CreatedAt.Format("02/01/2006"))
+		default:
+			valid++
+			fmt.Printf("linha %d: ✅ %s | %s | %dx | %s\n", row.Line, row.Product.Name,
+				money.Format(row.Product.TotalValue), row.Product.Installments, row.Product.CreatedAt.Format("02/01/2006"))
+		}
+	}
+	fmt.Printf("%d válido(s), %d duplicado(s), %d inválido(s).\n", valid, duplicates, invalidRows)
+
+	if *dryRun {
+		fmt.Println("Simulação: nenhum produto foi importado.")
+		return nil
+	}
+	if valid == 0 {
+		return nil
+	}
+
+	for _, row := range rows {
+		if row.Valid() {
+			if _, err := list.Add(row.Product, clock.RealNow(e.clock)); err != nil {
+				return err
+			}
+		}
+	}
+	list.Month = int(now.Month())
+	list.Year = now.Year()
+
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ %d produto(s) importado(s)!\n", valid)
+	return nil
+}
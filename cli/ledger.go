@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/ledger"
+)
+
+func runLedger(e env, args []string) error {
+	opts := ledger.DefaultOptions()
+	fs := newFlagSet("ledger", &e.profile)
+	file := fs.String("file", "", "arquivo do diário (padrão: saída padrão)")
+	from := fs.String("from", "", "primeiro mês no formato AAAA-MM (padrão: primeira parcela)")
+	to := fs.String("to", "", "último mês no formato AAAA-MM (padrão: última parcela)")
+	fs.StringVar(&opts.ExpensesAccount, "expenses", opts.ExpensesAccount, "conta de despesas")
+	fs.StringVar(&opts.LiabilitiesAccount, "liabilities", opts.LiabilitiesAccount, "conta dos cartões")
+	fs.StringVar(&opts.IncomeAccount, "income", opts.IncomeAccount, "conta do lucro mensal")
+	fs.StringVar(&opts.AssetsAccount, "assets", opts.AssetsAccount, "conta que recebe o lucro mensal")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var err error
+	if opts.From, err = parseMonthStart(*from); err != nil {
+		return err
+	}
+	if opts.To, err = parseMonthStart(*to); err != nil {
+		return err
+	}
+	if !opts.From.IsZero() && !opts.To.IsZero() && opts.To.Before(opts.From) {
+		return invalid("--to anterior a --from")
+	}
+
+	_, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	if *file == "" {
+		return ledger.Export(os.Stdout, list, opts)
+	}
+
+	f, err := os.Create(*file)
+	if err != nil {
+		return err
+	}
+	err = ledger.Export(f, list, opts)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ Diário exportado para %s\n", *file)
+	return nil
+}
+
+func parseMonthStart(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	year, month, err := clock.ParseMonth(value)
+	if err != nil {
+		return time.Time{}, invalid("mês inválido %q: use o formato AAAA-MM", value)
+	}
+	return time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local), nil
+}
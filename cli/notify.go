@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/notify"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+func runNotify(e env, args []string) error {
+	config, err := storage.LoadNotifyConfig()
+	if err != nil {
+		return err
+	}
+	if config.Days == 0 {
+		config.Days = notify.DefaultDays
+	}
+
+	fs := newFlagSet("notify", &e.profile)
+	fs.IntVar(&config.Days, "days", config.Days, "dias de antecedência das parcelas a vencer")
+	fs.StringVar(&config.Command, "command", config.Command, "comando executado com a notificação")
+	fs.StringVar(&config.Webhook, "webhook", config.Webhook, "URL que recebe a notificação em um POST JSON")
+	dryRun := fs.Bool("dry-run", false, "apenas mostra a notificação, sem enviá-la")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if config.Days < 0 || config.Days > 60 {
+		return invalid("quantidade de dias inválida: %d", config.Days)
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+	rules, err := storage.LoadRules(profile)
+	if err != nil {
+		return err
+	}
+
+	msg := notify.Build(profile, list, rules, e.clock.Now(), config.Days)
+	if msg.Empty() {
+		fmt.Println("Nada a notificar.")
+		return nil
+	}
+
+	if *dryRun {
+		fmt.Println(msg.Subject)
+		fmt.Print("\n" + msg.Text)
+		return nil
+	}
+	if !config.HasHooks() {
+		return invalid("%w: configure data/notify.json ou use --command/--webhook", notify.ErrNoHooks)
+	}
+
+	if err := notify.Deliver(config, msg, clock.RealNow(e.clock)); err != nil {
+		return err
+	}
+	fmt.Printf("✅ Notificação enviada: %s\n", msg.Subject)
+	return nil
+}
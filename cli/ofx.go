@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/ofx"
+)
+
+func runOFX(e env, args []string) error {
+	fs := newFlagSet("ofx", &e.profile)
+	file := fs.String("file", "", "extrato OFX a importar")
+	card := fs.String("card", "", "cartão do extrato")
+	dryRun := fs.Bool("dry-run", false, "apenas mostra a conciliação")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return invalid("uso: ofx --file ARQUIVO [--card CARTÃO] [--dry-run]")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	stmt, err := ofx.Parse(f)
+	f.Close()
+	if err != nil {
+		return usageError{err}
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	entries := ofx.Reconcile(list.Products, stmt, *card)
+	var matched, created, ambiguous, unmatched, changes int
+	for _, entry := range entries {
+		t := entry.Transaction
+		line := fmt.Sprintf("%s | %s | %s", t.Date.Format("02/01/2006"), t.Memo, money.Format(-t.Amount))
+		switch entry.Status {
+		case ofx.StatusMatched:
+			matched++
+			note := ""
+			if entry.AlreadyPaid {
+				note = " (já paga)"
+			} else {
+				changes++
+			}
+			fmt.Printf("✅ %s → %s, parcela %d/%d%s\n", line, entry.Product.Name, entry.Number, entry.Installments, note)
+		case ofx.StatusNew:
+			created++
+			changes++
+			fmt.Printf("🆕 %s → novo produto '%s' em %dx de %s, parcela %d/%d\n", line, entry.Product.Name,
+				entry.Installments, money.Format(entry.Product.Parcel), entry.Number, entry.Installments)
+		case ofx.StatusAmbiguous:
+			ambiguous++
+			fmt.Printf("⚠️  %s → talvez %s, parcela %d/%d (nome diferente, não conciliada)\n", line, entry.Product.Name,
+				entry.Number, entry.Installments)
+		default:
+			unmatched++
+			fmt.Printf("❔ %s → não conciliada\n", line)
+		}
+	}
+	fmt.Printf("%d conciliada(s), %d produto(s) novo(s), %d ambígua(s), %d não conciliada(s).\n", matched, created, ambiguous, unmatched)
+
+	if *dryRun {
+		fmt.Println("Simulação: nenhuma alteração foi salva.")
+		return nil
+	}
+	if changes == 0 {
+		return nil
+	}
+
+	if err := ofx.Apply(&list, entries, clock.RealNow(e.clock)); err != nil {
+		return err
+	}
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+
+	fmt.Println("✅ Extrato conciliado!")
+	return nil
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/api"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/web"
+)
+
+const tokenEnv = "SSC_API_TOKEN"
+
+func runServe(e env, args []string) error {
+	fs := newFlagSet("serve", &e.profile)
+	addr := fs.String("addr", "localhost:8080", "endereço do servidor; sem host, só para este computador")
+	token := fs.String("token", os.Getenv(tokenEnv), "token exigido no cabeçalho Authorization: Bearer")
+	origin := fs.String("allow-origin", "", "origem liberada para CORS, como http://192.168.0.10:3000")
+	noDashboard := fs.Bool("no-dashboard", false, "serve apenas a API, sem o painel web")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	host, port, err := net.SplitHostPort(*addr)
+	if err != nil {
+		return invalid("endereço inválido %q: use HOST:PORTA, como localhost:8080", *addr)
+	}
+	if host == "" {
+		*addr = net.JoinHostPort("127.0.0.1", port)
+	} else if *token == "" && !api.IsLoopback(host) {
+		return invalid("para servir a API na rede use --token ou %s", tokenEnv)
+	}
+
+	profile, _, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/", api.New(api.Options{
+		Clock: e.clock,
+		Load: func() (product.ProductList, error) {
+			_, list, err := openList(profile)
+			return list, err
+		},
+		Save: func(list *product.ProductList) error {
+			return saveList(profile, list)
+		},
+		Token:       *token,
+		AllowOrigin: *origin,
+	}))
+	if !*noDashboard {
+		mux.Handle("/", web.Handler())
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Printf("Servindo a API do perfil '%s' em http://%s/api (Ctrl+C para sair)\n", profile, displayAddr(*addr))
+	if !*noDashboard {
+		fmt.Printf("Painel web em http://%s/\n", displayAddr(*addr))
+	}
+	return server.ListenAndServe()
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+)
+
+func runPeople(e env, args []string) error {
+	fs := newFlagSet("people", &e.profile)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(positional) == 0:
+		fmt.Printf("%s (titular) | Renda: %s\n", personLabel(list.Owner), money.Format(list.MonthlyProfit))
+		for _, person := range list.People {
+			fmt.Printf("%s | Renda: %s\n", person.Name, money.Format(person.MonthlyProfit))
+		}
+		return nil
+	case len(positional) == 3 && positional[0] == "set":
+		if isOwnerLabel(positional[1]) {
+			return usageError{product.ErrOwnerIncome}
+		}
+		income, err := money.Parse(positional[2])
+		if err != nil {
+			return invalid("renda inválida: %q", positional[2])
+		}
+		if err := list.SetPerson(positional[1], income, clock.RealNow(e.clock)); err != nil {
+			return usageError{err}
+		}
+	case len(positional) == 2 && positional[0] == "remove":
+		if err := list.RemovePerson(positional[1], clock.RealNow(e.clock)); err != nil {
+			return usageError{err}
+		}
+	case len(positional) == 2 && positional[0] == "owner":
+		if err := list.SetOwner(positional[1], clock.RealNow(e.clock)); err != nil {
+			return usageError{err}
+		}
+	default:
+		return invalid("uso: people [set NOME RENDA | remove NOME | owner NOME]")
+	}
+
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+	fmt.Println("✅ Pessoas atualizadas!")
+	return nil
+}
+
+func runSplit(e env, args []string) error {
+	fs := newFlagSet("split", &e.profile)
+	id := fs.Int("id", 0, "id do produto")
+	paidBy := fs.String("paid-by", "", "quem pagou a compra (padrão: o titular do perfil)")
+	clear := fs.Bool("clear", false, "remove a divisão do produto")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if *clear && (len(positional) > 0 || *paidBy != "") {
+		return invalid("--clear não aceita partes nem --paid-by")
+	}
+	if !*clear && len(positional) == 0 && *paidBy == "" {
+		return invalid("uso: split --id ID [--paid-by NOME] PESSOA=PARTE... | --clear")
+	}
+
+	var shares []product.Share
+	for _, arg := range positional {
+		person, value, ok := strings.Cut(arg, "=")
+		if !ok || strings.TrimSpace(person) == "" {
+			return invalid("parte inválida %q: use PESSOA=50%% ou PESSOA=VALOR", arg)
+		}
+		share, err := product.ParseShare(personInput(person), value)
+		if err != nil {
+			return invalid("%w: %q", err, arg)
+		}
+		shares = append(shares, share)
+	}
+
+	profile, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	if err := list.SetSplit(*id, personInput(*paidBy), shares, clock.RealNow(e.clock)); errors.Is(err, product.ErrProductNotFound) {
+		return invalid("%w: id %d", err, *id)
+	} else if err != nil {
+		return usageError{err}
+	}
+	if err := saveList(profile, &list); err != nil {
+		return err
+	}
+
+	idx, _ := list.FindByID(*id)
+	p := list.Products[idx]
+	if !p.IsShared() {
+		fmt.Printf("✅ Divisão de '%s' removida!\n", p.Name)
+		return nil
+	}
+	fmt.Printf("✅ Divisão de '%s' atualizada! Pago por %s.\n", p.Name, personLabel(list.DisplayName(p.PaidBy)))
+	for _, key := range p.Participants() {
+		fmt.Printf("%s: %s por parcela\n", personLabel(list.DisplayName(key)), money.Format(p.Parcel*p.ShareOf(key)))
+	}
+	return nil
+}
+
+func runSettle(e env, args []string) error {
+	fs := newFlagSet("settle", &e.profile)
+	month := fs.String("month", "", "mês inicial no formato AAAA-MM")
+	months := fs.Int("months", 1, "quantidade de meses a partir do mês inicial")
+	format := fs.String("format", report.FormatText, "formato de saída: text ou json")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *format != report.FormatText && *format != report.FormatJSON {
+		return invalid("formato inválido: %s", *format)
+	}
+	if *months < 1 || *months > 120 {
+		return invalid("quantidade de meses inválida: %d", *months)
+	}
+
+	year, m, err := parseMonth(e.clock, *month)
+	if err != nil {
+		return err
+	}
+
+	_, list, err := openList(e.profile)
+	if err != nil {
+		return err
+	}
+
+	settlement := report.Settle(list, year, m, *months)
+	if *format == report.FormatJSON {
+		return report.WriteJSON(os.Stdout, settlement)
+	}
+
+	if len(settlement.Items) == 0 {
+		fmt.Println("Nenhuma compra dividida no período.")
+		return nil
+	}
+	for _, item := range settlement.Items {
+		fmt.Printf("%02d/%d | %s (%d/%d) | %s → %s: %s\n", item.Month, item.Year, item.Product,
+			item.InstallmentNumber, item.Installments, personLabel(item.From), personLabel(item.To), money.Format(item.Amount))
+	}
+	fmt.Println()
+	for _, transfer := range settlement.Transfers {
+		fmt.Printf("%s deve %s a %s\n", personLabel(transfer.From), money.Format(transfer.Amount), personLabel(transfer.To))
+	}
+	if len(settlement.Transfers) == 0 {
+		fmt.Println("Ninguém deve nada.")
+	}
+	return nil
+}
+
+func personLabel(name string) string {
+	if name == "" {
+		return i18n.T("Eu")
+	}
+	return name
+}
+
+func isOwnerLabel(name string) bool {
+	for _, label := range i18n.Variants("Eu") {
+		if product.SamePerson(name, label) {
+			return true
+		}
+	}
+	return false
+}
+
+func personInput(name string) string {
+	if isOwnerLabel(name) {
+		return ""
+	}
+	return name
+}
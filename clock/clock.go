@@ -0,0 +1,68 @@
+package clock
+
+import "time"
+
+type Clock interface {
+	Now() time.Time
+}
+
+type System struct{}
+
+func (System) Now() time.Time {
+	return time.Now()
+}
+
+type Fixed struct {
+	Time time.Time
+}
+
+func (f Fixed) Now() time.Time {
+	return f.Time
+}
+
+type Offset struct {
+	Base   Clock
+	Offset time.Duration
+}
+
+func (o Offset) Now() time.Time {
+	return o.Base.Now().Add(o.Offset)
+}
+
+func AsOf(base Clock, year, month int) Clock {
+	now := base.Now()
+
+	lastDay := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, now.Location()).Day()
+	day := min(now.Day(), lastDay)
+
+	target := time.Date(year, time.Month(month), day,
+		now.Hour(), now.Minute(), now.Second(), now.Nanosecond(), now.Location())
+	return Offset{Base: base, Offset: target.Sub(now)}
+}
+
+func ParseMonth(value string) (int, int, error) {
+	date, err := time.Parse("2006-01", value)
+	if err != nil {
+		return 0, 0, err
+	}
+	return date.Year(), int(date.Month()), nil
+}
+
+func IsShifted(c Clock) bool {
+	o, ok := c.(Offset)
+	return ok && o.Offset != 0
+}
+
+func Real(c Clock) Clock {
+	for {
+		o, ok := c.(Offset)
+		if !ok {
+			return c
+		}
+		c = o.Base
+	}
+}
+
+func RealNow(c Clock) time.Time {
+	return Real(c).Now()
+}
@@ -0,0 +1,189 @@
+package csvio
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+const (
+	ColumnName         = "name"
+	ColumnTotal        = "total"
+	ColumnInstallments = "installments"
+	ColumnPurchaseDate = "purchase_date"
+	ColumnCategory     = "category"
+	ColumnCard         = "card"
+)
+
+var Header = []string{ColumnName, ColumnTotal, ColumnInstallments, ColumnPurchaseDate, ColumnCategory, ColumnCard}
+
+var ErrMissingColumn = errors.New("coluna obrigatória ausente no cabeçalho")
+var ErrInvalidDate = errors.New("data de compra inválida: use AAAA-MM-DD ou DD/MM/AAAA")
+var ErrEmptyFile = errors.New("arquivo CSV vazio")
+
+type Row struct {
+	Line      int
+	Product   product.Product
+	Err       error
+	Duplicate bool
+}
+
+func (r Row) Valid() bool {
+	return r.Err == nil && !r.Duplicate
+}
+
+func Export(w io.Writer, products []product.Product) error {
+	writer := csv.NewWriter(w)
+	writer.Write(Header)
+
+	for _, p := range products {
+		writer.Write([]string{
+			p.Name,
+			strconv.FormatFloat(math.Round(p.TotalValue*100)/100, 'f', 2, 64),
+			strconv.Itoa(p.Installments),
+			p.CreatedAt.Format(time.DateOnly),
+			p.Category,
+			p.Card,
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func Read(r io.Reader, existing []product.Product, now time.Time) ([]Row, error) {
+	buffered := bufio.NewReader(r)
+	first, err := buffered.Peek(512)
+	if len(first) == 0 {
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		return nil, ErrEmptyFile
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if firstLine, _, _ := strings.Cut(string(first), "\n"); !strings.Contains(firstLine, ",") && strings.Contains(firstLine, ";") {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, ErrEmptyFile
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = i
+	}
+	for _, required := range []string{ColumnName, ColumnTotal, ColumnInstallments} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, required)
+		}
+	}
+
+	var rows []Row
+	accepted := append([]product.Product(nil), existing...)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			rows = append(rows, Row{Line: parseErr.Line, Err: parseErr.Err})
+			continue
+		}
+		if isBlank(record) {
+			continue
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := Row{Line: line}
+		row.Product, row.Err = parseProduct(field, now)
+		if row.Err == nil {
+			for _, other := range accepted {
+				if row.Product.SameAs(other) {
+					row.Duplicate = true
+					break
+				}
+			}
+			if !row.Duplicate {
+				accepted = append(accepted, row.Product)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseProduct(field func(string) string, now time.Time) (product.Product, error) {
+	total, err := money.Parse(field(ColumnTotal))
+	if err != nil {
+		return product.Product{}, product.ErrInvalidValue
+	}
+
+	installments, err := strconv.Atoi(field(ColumnInstallments))
+	if err != nil {
+		return product.Product{}, product.ErrInvalidInstallments
+	}
+
+	purchaseDate := now
+	if value := field(ColumnPurchaseDate); value != "" {
+		if purchaseDate, err = parseDate(value); err != nil {
+			return product.Product{}, err
+		}
+	}
+
+	p, err := product.New(field(ColumnName), total, installments, purchaseDate)
+	if err != nil {
+		return product.Product{}, err
+	}
+	p.Name = strings.TrimSpace(p.Name)
+	p.Category = field(ColumnCategory)
+	p.Card = field(ColumnCard)
+	return p, nil
+}
+
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range []string{time.DateOnly, "02/01/2006"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, ErrInvalidDate
+}
+
+func isBlank(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -1,3 +1,10 @@
 module github.com/pedrorcruzz/smart-spending-checker
 
 go 1.24.3
+
+require (
+	golang.org/x/crypto v0.38.0
+	golang.org/x/term v0.32.0
+)
+
+require golang.org/x/sys v0.33.0 // indirect
@@ -0,0 +1,487 @@
+package i18n
+
+var enUS = map[string]string{
+	// Main menu
+	" Gestor Inteligente de Gastos ":   " Smart Spending Checker ",
+	" MENU PRINCIPAL":                  " MAIN MENU",
+	"Perfil: %s\n":                     "Profile: %s\n",
+	"🕒 Visualizando como em %02d/%d\n": "🕒 Viewing as of %02d/%d\n",
+	"\nPor favor, defina seu lucro mensal antes de adicionar produtos.": "\nPlease set your monthly profit before adding products.",
+	"1. Adicionar produto":             "1. Add product",
+	"2. Remover produto":               "2. Remove product",
+	"3. Listar meses":                  "3. List months",
+	"4. Atualizar lucro mensal":        "4. Update monthly profit",
+	"5. Editar produto":                "5. Edit product",
+	"6. Antecipar parcelas":            "6. Anticipate installments",
+	"7. Configurar porcentagem segura": "7. Set safe percentage",
+	"Desfazer":                         "Undo",
+	"Refazer":                          "Redo",
+	"8. Perfis":                        "8. Profiles",
+	"9. Criptografia dos dados":        "9. Data encryption",
+	"10. Histórico de produto":         "10. Product history",
+	"11. Buscar produto":               "11. Search product",
+	"12. Idioma":                       "12. Language",
+	"13. Câmbio":                       "13. Exchange rates",
+	"14. Importar/exportar":            "14. Import/export",
+	"15. Gráfico dos próximos meses":   "15. Chart of the next months",
+	"16. Alertas":                      "16. Alerts",
+	"17. Divisão de compras":           "17. Split purchases",
+	"0. Sair":                          "0. Exit",
+	"0. Voltar ao Menu":                "0. Back to Menu",
+	"Escolha uma opcão: ":              "Choose an option: ",
+	"Opcão inválida.":                  "Invalid option.",
+	"Saindo...":                        "Exiting...",
+	"Erro ao carregar os dados:":       "Error loading data:",
+	"Erro ao salvar os dados:":         "Error saving data:",
+	"Erro ao salvar os dados: ":        "Error saving data: ",
+	"\n⚠️  Os dados deste perfil foram modificados em outra sessão.":                     "\n⚠️  This profile's data was changed in another session.",
+	"Recarregar e descartar suas alterações (r) ou mesclá-las com os dados atuais (m)? ": "Reload and discard your changes (r) or merge them with the current data (m)? ",
+	"✅ Dados recarregados.": "✅ Data reloaded.",
+	"⚠️  %d alteração(ões) não puderam ser aplicadas e foram descartadas.\n": "⚠️  %d change(s) could not be applied and were discarded.\n",
+	"✅ Alterações mescladas.": "✅ Changes merged.",
+
+	// Summary
+	" RESUMO DO MÊS (%02d/%d - %s) ":                                              " MONTH SUMMARY (%02d/%d - %s) ",
+	"Lucro mensal: %s\n":                                                          "Monthly profit: %s\n",
+	"Total de parcelas: %s\n":                                                     "Total installments: %s\n",
+	"Usado: %s%% | Para reinvestir: %s%% (%s)\n":                                  "Used: %s%% | To reinvest: %s%% (%s)\n",
+	"Usado: %s%% | Para reinvestir: %s%%\n":                                       "Used: %s%% | To reinvest: %s%%\n",
+	"Disponível para gastos: %s%% (%s) | Restante: %s\n":                          "Available for spending: %s%% (%s) | Remaining: %s\n",
+	"✅ Você pode usar parte do seu lucro para pagar as parcelas!":                 "✅ You can use part of your profit to pay the installments!",
+	"❌ Não recomendado. Crie uma caixinha separada para alguns produtos!":         "❌ Not recommended. Set aside a separate savings box for some products!",
+	"💡 Sugestão: Separe o produto '%s' (Parcela: %s) em uma caixinha separada.\n": "💡 Suggestion: Move the product '%s' (Installment: %s) to a separate savings box.\n",
+	"💡 Sugestão: Separe os seguintes produtos em uma caixinha:":                   "💡 Suggestion: Move the following products to a savings box:",
+	"  %d. %s (Parcela: %s)\n":                                                    "  %d. %s (Installment: %s)\n",
+	"  Total a separar: %s\n":                                                     "  Total to set aside: %s\n",
+	" PRODUTOS ATIVOS NESTE MÊS ":                                                 " PRODUCTS ACTIVE THIS MONTH ",
+	"%d. %s | Total: %s | Parcela: %s (%d/%d)%s | Adicionado em: %s\n":            "%d. %s | Total: %s | Installment: %s (%d/%d)%s | Added on: %s\n",
+	"%d. %s | Total: %s | Parcela: %s (%d/%d)%s\n":                                "%d. %s | Total: %s | Installment: %s (%d/%d)%s\n",
+	"Nenhum produto ativo para %s de %d.\n":                                       "No active products for %s %d.\n",
+
+	// Months
+	" LISTAR MESES ":                            " LIST MONTHS ",
+	" PRODUTOS DE %s/%d ":                       " PRODUCTS OF %s/%d ",
+	"\nNenhum produto encontrado para %s/%d.\n": "\nNo products found for %s/%d.\n",
+	"\nSelecione o ano (0 para voltar):":        "\nSelect the year (0 to go back):",
+	"\nSelecione o mês (0 para voltar):":        "\nSelect the month (0 to go back):",
+	"\nSelecione o produto (0 para voltar):":    "\nSelect the product (0 to go back):",
+	"Ano: ":                                     "Year: ",
+	"Mês: ":                                     "Month: ",
+	"Produto: ":                                 "Product: ",
+	"Ano inválido.":                             "Invalid year.",
+	"Mês inválido.":                             "Invalid month.",
+	"Produto inválido.":                         "Invalid product.",
+	"%d. %s | Total: %s | Parcelas: %d | Adicionado em: %s\n": "%d. %s | Total: %s | Installments: %d | Added on: %s\n",
+	"\nPressione Enter para voltar...":                        "\nPress Enter to go back...",
+
+	// Products
+	" ADICIONAR PRODUTO ":                                               " ADD PRODUCT ",
+	" REMOVER PRODUTO ":                                                 " REMOVE PRODUCT ",
+	" EDITAR PRODUTO ":                                                  " EDIT PRODUCT ",
+	" ANTECIPAR PARCELAS ":                                              " ANTICIPATE INSTALLMENTS ",
+	"Nome do produto (0 para voltar): ":                                 "Product name (0 to go back): ",
+	"Nome invalido.":                                                    "Invalid name.",
+	"Valor total do produto (R$) (0 para voltar): ":                     "Product total value (R$) (0 to go back): ",
+	"Valor invalido.":                                                   "Invalid value.",
+	"Em quantas vezes será parcelado (0 para voltar): ":                 "Number of installments (0 to go back): ",
+	"Número de parcelas inválido.":                                      "Invalid number of installments.",
+	"Categoria (opcional, Enter para deixar em branco): ":               "Category (optional, Enter to leave blank): ",
+	"✅ Produto adicionado! Parcela mensal: %s\n":                        "✅ Product added! Monthly installment: %s\n",
+	"Nenhum produto para remover.":                                      "No products to remove.",
+	"\nTem certeza que deseja remover '%s'? (s/n): ":                    "\nAre you sure you want to remove '%s'? (y/n): ",
+	"Operação cancelada.":                                               "Operation canceled.",
+	"✅ Produto removido!":                                               "✅ Product removed!",
+	"Nenhum produto para editar.":                                       "No products to edit.",
+	"Nome atual: %s. Novo nome (ou Enter para manter, 0 para voltar): ": "Current name: %s. New name (or Enter to keep, 0 to go back): ",
+	"Valor total atual: %s. Novo valor (ou Enter para manter, 0 para voltar): ":            "Current total value: %s. New value (or Enter to keep, 0 to go back): ",
+	"Parcelas atuais: %d. Novo número de parcelas (ou Enter para manter, 0 para voltar): ": "Current installments: %d. New number of installments (or Enter to keep, 0 to go back): ",
+	"Categoria atual: %s. Nova categoria (ou Enter para manter, - para limpar): ":          "Current category: %s. New category (or Enter to keep, - to clear): ",
+	"✅ Produto atualizado!":                                                    "✅ Product updated!",
+	"Nenhum produto para antecipar parcelas.":                                  "No products to anticipate installments.",
+	"Este produto já foi totalmente pago.":                                     "This product has already been fully paid.",
+	"Quantas parcelas deseja antecipar? (Total restante: %d, 0 para voltar): ": "How many installments do you want to anticipate? (Remaining: %d, 0 to go back): ",
+	"Quantidade inválida.":                                                     "Invalid amount.",
+	"Valor total para antecipar %d parcelas: %s\n":                             "Total to anticipate %d installments: %s\n",
+	"Deseja confirmar a antecipação? (s/n): ":                                  "Confirm the anticipation? (y/n): ",
+	"✅ Parcelas antecipadas com sucesso!":                                      "✅ Installments anticipated successfully!",
+
+	// Profit and safe percentage
+	" ATUALIZAR LUCRO MENSAL ":                 " UPDATE MONTHLY PROFIT ",
+	"Novo lucro mensal (R$) (0 para voltar): ": "New monthly profit (R$) (0 to go back): ",
+	"✅ Lucro mensal atualizado!":               "✅ Monthly profit updated!",
+	" CONFIGURAR PORCENTAGEM SEGURA ":          " SET SAFE PERCENTAGE ",
+	"A porcentagem segura define quanto do seu lucro mensal deve estar disponível para reinvestimento.": "The safe percentage defines how much of your monthly profit should remain available for reinvestment.",
+	"Recomendação: Mantenha pelo menos 70% do seu lucro disponível para reinvestimento.":                "Recommendation: Keep at least 70% of your profit available for reinvestment.",
+	"Porcentagem atual: %s%%\n":                                       "Current percentage: %s%%\n",
+	"Nova porcentagem segura (ou Enter para manter, 0 para voltar): ": "New safe percentage (or Enter to keep, 0 to go back): ",
+	"Mantendo a porcentagem atual.":                                   "Keeping the current percentage.",
+	"Valor inválido. Mantendo a porcentagem atual.":                   "Invalid value. Keeping the current percentage.",
+	"✅ Porcentagem segura atualizada para %s%%!\n":                    "✅ Safe percentage updated to %s%%!\n",
+	"Porcentagem segura configurada: %s%%\n":                          "Safe percentage set: %s%%\n",
+
+	// Language
+	" IDIOMA ":       " LANGUAGE ",
+	"✅ Idioma: %s\n": "✅ Language: %s\n",
+
+	// Undo, redo and history
+	"↩️  Desfeito: %s\n":              "↩️  Undone: %s\n",
+	"↪️  Refeito: %s\n":               "↪️  Redone: %s\n",
+	"adicionar '%s'":                  "add '%s'",
+	"remover '%s'":                    "remove '%s'",
+	"editar '%s'":                     "edit '%s'",
+	"antecipar %d parcela(s) de '%s'": "anticipate %d installment(s) of '%s'",
+	"lucro mensal %s → %s":            "monthly profit %s → %s",
+	"porcentagem segura %s%% → %s%%":  "safe percentage %s%% → %s%%",
+	"desfazer":                        "undo",
+	"refazer":                         "redo",
+	" HISTÓRICO DE PRODUTO ":          " PRODUCT HISTORY ",
+	" HISTÓRICO DE '%s' \n":           " HISTORY OF '%s' \n",
+	"Cadastrado em: %s\n":             "Registered on: %s\n",
+	"Nenhuma alteração registrada para este produto.": "No changes recorded for this product.",
+	"Desfeito: ": "Undone: ",
+	"Refeito: ":  "Redone: ",
+	"Adicionado | Nome: %s | Total: %s | Parcelas: %d": "Added | Name: %s | Total: %s | Installments: %d",
+	"Removido | Nome: %s | Total: %s | Parcelas: %d":   "Removed | Name: %s | Total: %s | Installments: %d",
+	"Antecipação | Parcelas: %d → %d":                  "Anticipation | Installments: %d → %d",
+	"Nome: %s → %s":                                    "Name: %s → %s",
+	"Categoria: %s → %s":                               "Category: %s → %s",
+	"Total: %s → %s":                                   "Total: %s → %s",
+	"Parcelas: %d → %d":                                "Installments: %d → %d",
+	"Editado | ":                                       "Edited | ",
+	"Editado | Sem alterações":                         "Edited | No changes",
+
+	// Profiles
+	" PERFIS ":                              " PROFILES ",
+	"Perfil atual: %s\n":                    "Current profile: %s\n",
+	"1. Trocar perfil":                      "1. Switch profile",
+	"2. Criar perfil":                       "2. Create profile",
+	"3. Visão combinada":                    "3. Combined view",
+	"Erro ao listar perfis:":                "Error listing profiles:",
+	"\nSelecione o perfil (0 para voltar):": "\nSelect the profile (0 to go back):",
+	" (atual)":                              " (current)",
+	"Perfil: ":                              "Profile: ",
+	"Perfil inválido.":                      "Invalid profile.",
+	"\nNome do novo perfil (letras, números, - e _) (0 para voltar): ":           "\nNew profile name (letters, numbers, - and _) (0 to go back): ",
+	"Não foi possível criar o perfil:":                                           "Could not create the profile:",
+	"✅ Perfil '%s' criado!\n":                                                    "✅ Profile '%s' created!\n",
+	"Erro ao salvar configuração:":                                               "Error saving settings:",
+	"✅ Perfil ativo: %s\n":                                                       "✅ Active profile: %s\n",
+	" VISÃO COMBINADA (%02d/%d - %s) ":                                           " COMBINED VIEW (%02d/%d - %s) ",
+	"%s | 🔒 Perfil bloqueado\n":                                                  "%s | 🔒 Profile locked\n",
+	"%s | Erro ao carregar: %v\n":                                                "%s | Error loading: %v\n",
+	"%s | Lucro: %s | Parcelas: %s | Usado: %s%% | Produtos ativos: %d\n":        "%s | Profit: %s | Installments: %s | Used: %s%% | Active products: %d\n",
+	"TOTAL | Lucro: %s | Parcelas: %s | Usado: %s%% | Produtos ativos: %d\n":     "TOTAL | Profit: %s | Installments: %s | Used: %s%% | Active products: %d\n",
+	"✅ Somando todos os perfis, as parcelas estão dentro da porcentagem segura.": "✅ Across all profiles, the installments are within the safe percentage.",
+	"❌ Somando todos os perfis, as parcelas ultrapassam a porcentagem segura.":   "❌ Across all profiles, the installments exceed the safe percentage.",
+
+	// Encryption
+	" CRIPTOGRAFIA DOS DADOS ":               " DATA ENCRYPTION ",
+	"Perfil '%s': 🔒 criptografado\n":         "Profile '%s': 🔒 encrypted\n",
+	"Perfil '%s': 🔓 sem criptografia\n":      "Profile '%s': 🔓 not encrypted\n",
+	"1. Ativar criptografia":                 "1. Enable encryption",
+	"1. Trocar senha":                        "1. Change passphrase",
+	"2. Desativar criptografia":              "2. Disable encryption",
+	"Senha do perfil '%s' (0 para voltar): ": "Passphrase for profile '%s' (0 to go back): ",
+	"Número máximo de tentativas atingido.":  "Maximum number of attempts reached.",
+	"Nova senha (0 para voltar): ":           "New passphrase (0 to go back): ",
+	"Confirme a nova senha: ":                "Confirm the new passphrase: ",
+	"A senha não pode ser vazia.":            "The passphrase cannot be empty.",
+	"As senhas não conferem.":                "The passphrases do not match.",
+	"\nSenha atual: ":                        "\nCurrent passphrase: ",
+	"Erro ao ler os dados:":                  "Error reading data:",
+	"Erro ao ativar a criptografia:":         "Error enabling encryption:",
+	"Erro ao trocar a senha:":                "Error changing the passphrase:",
+	"Erro ao desativar a criptografia:":      "Error disabling encryption:",
+	"✅ Criptografia ativada!":                "✅ Encryption enabled!",
+	"✅ Senha alterada!":                      "✅ Passphrase changed!",
+	"✅ Criptografia desativada.":             "✅ Encryption disabled.",
+	"\n⚠️  Guarde a senha em local seguro: sem ela não é possível recuperar os dados.": "\n⚠️  Keep the passphrase somewhere safe: without it the data cannot be recovered.",
+	"\nOs dados voltarão a ser salvos em texto puro. Confirmar? (s/n): ":               "\nData will be saved in plain text again. Confirm? (y/n): ",
+
+	// Search
+	" BUSCAR PRODUTO ":                                            " SEARCH PRODUCT ",
+	"Nenhum produto cadastrado.":                                  "No products registered.",
+	"Buscar por nome, categoria ou valor (0 para voltar): ":       "Search by name, category or value (0 to go back): ",
+	"Nenhum produto encontrado para '%s'.\n":                      "No products found for '%s'.\n",
+	"%d produtos encontrados, mostrando os %d mais relevantes.\n": "%d products found, showing the %d most relevant.\n",
+	"quitado":           "paid off",
+	"parcela %d/%d":     "installment %d/%d",
+	"começa em %02d/%d": "starts in %02d/%d",
+	"%d. %s | %s | Total: %s | Parcela: %s | %s | Adicionado em: %s\n": "%d. %s | %s | Total: %s | Installment: %s | %s | Added on: %s\n",
+	"\nO que deseja fazer com '%s'?\n":                                 "\nWhat do you want to do with '%s'?\n",
+	"1. Editar":                                                        "1. Edit",
+	"2. Remover":                                                       "2. Remove",
+	"3. Antecipar parcelas":                                            "3. Anticipate installments",
+	"sem categoria":                                                    "no category",
+
+	// Full-screen mode
+	"%s Gestor Inteligente de Gastos %s  Perfil: %s": "%s Smart Spending Checker %s  Profile: %s",
+	"  🕒 Visualizando como em %02d/%d":               "  🕒 Viewing as of %02d/%d",
+	"%s%s de %d%s — %d produto(s) ativo(s)":          "%s%s %d%s — %d active product(s)",
+	"Nome":        "Name",
+	"Valor total": "Total value",
+	"Parcelas":    "Installments",
+	"Parcela":     "Installment",
+	"Nº":          "No.",
+	"Total":       "Total",
+	"novo":        "new",
+	"  Nenhum produto ativo neste mês. Pressione 'a' para adicionar.":         "  No products active this month. Press 'a' to add one.",
+	"Lucro mensal: %s   Total de parcelas: %s   Pode gastar: %s":              "Monthly profit: %s   Total installments: %s   Can spend: %s",
+	"Usado: %6s%% [%s] limite de uso %s%% (porcentagem segura %s%%)":          "Used: %6s%% [%s] usage limit %s%% (safe percentage %s%%)",
+	"✅ Dentro da porcentagem segura.":                                         "✅ Within the safe percentage.",
+	"❌ Acima do limite: não é recomendado comprar mais parcelados neste mês.": "❌ Over the limit: buying more in installments this month is not recommended.",
+	"Remover '%s'? (s/n)": "Remove '%s'? (y/n)",
+	"Editando ":           "Editing ",
+	"Tab/↑/↓ campo  Enter próximo/salvar  Backspace apagar  Esc cancelar":                                 "Tab/↑/↓ field  Enter next/save  Backspace delete  Esc cancel",
+	"←/→ mês  ↑/↓ produto  h hoje  a adicionar  Enter/e editar  d remover  u desfazer  r refazer  q sair": "←/→ month  ↑/↓ product  h today  a add  Enter/e edit  d remove  u undo  r redo  q quit",
+	"Erro: ":             "Error: ",
+	"Remoção cancelada.": "Removal canceled.",
+	"Desfeito.":          "Undone.",
+	"Refeito.":           "Redone.",
+	"✅ Produto '%s' adicionado! Parcela mensal: %s": "✅ Product '%s' added! Monthly installment: %s",
+	"✅ Produto '%s' removido!":                      "✅ Product '%s' removed!",
+
+	// Exchange rates
+	" CÂMBIO ":            " EXCHANGE RATES ",
+	"1. Definir cotação":  "1. Set rate",
+	"2. Remover moeda":    "2. Remove currency",
+	"3. Definir IOF":      "3. Set IOF",
+	"Atualizada em: %s\n": "Updated on: %s\n",
+	"Convertido: %s = %s (cotação %s, IOF %s%%)\n":                     "Converted: %s = %s (rate %s, IOF %s%%)\n",
+	"Cotação %s → BRL (0 para voltar): ":                               "%s → BRL rate (0 to go back): ",
+	"Cotação %s → BRL (Enter para usar %s da tabela, 0 para voltar): ": "%s → BRL rate (Enter to use %s from the table, 0 to go back): ",
+	"Cotação em reais: ":                                               "Rate in reais: ",
+	"Cotação inválida.":                                                "Invalid rate.",
+	"Erro ao carregar a tabela de câmbio:":                             "Error loading the exchange rate table:",
+	"Erro ao salvar a tabela de câmbio:":                               "Error saving the exchange rate table:",
+	"IOF em porcentagem (0 para não cobrar): ":                         "IOF as a percentage (0 to not charge it): ",
+	"IOF: %s%%\n":                  "IOF: %s%%\n",
+	"Incluir IOF de %s%%? (s/n): ": "Include IOF of %s%%? (y/n): ",
+	"Moeda (Enter para BRL, ou um código como USD ou EUR): ": "Currency (Enter for BRL, or a code such as USD or EUR): ",
+	"Moeda (código como USD ou EUR): ":                       "Currency (code such as USD or EUR): ",
+	"Moeda a remover: ":                                      "Currency to remove: ",
+	"Nenhuma cotação cadastrada.":                            "No rates registered.",
+	"Valor total do produto (%s) (0 para voltar): ":          "Product total value (%s) (0 to go back): ",
+	"✅ Tabela de câmbio atualizada!":                         "✅ Exchange rate table updated!",
+
+	// CSV import and export
+	" IMPORTAR/EXPORTAR ":                                                   " IMPORT/EXPORT ",
+	" PRÉVIA DA IMPORTAÇÃO ":                                                " IMPORT PREVIEW ",
+	"%d válido(s), %d duplicado(s), %d inválido(s).\n":                      "%d valid, %d duplicate(s), %d invalid.\n",
+	"1. Importar produtos (CSV)":                                            "1. Import products (CSV)",
+	"2. Exportar produtos (CSV)":                                            "2. Export products (CSV)",
+	"3. Importar extrato OFX":                                               "3. Import OFX statement",
+	"4. Exportar diário hledger/ledger":                                     "4. Export hledger/ledger journal",
+	"5. Exportar calendário de vencimentos (.ics)":                          "5. Export due date calendar (.ics)",
+	"Arquivo CSV a importar (0 para voltar): ":                              "CSV file to import (0 to go back): ",
+	"Arquivo CSV de destino (0 para voltar): ":                              "Destination CSV file (0 to go back): ",
+	"Cabeçalho do CSV:":                                                     "CSV header:",
+	"Erro ao abrir o arquivo:":                                              "Error opening the file:",
+	"Erro ao exportar:":                                                     "Error exporting:",
+	"Erro ao ler o CSV:":                                                    "Error reading the CSV:",
+	"Importar %d produto(s)? (s/n): ":                                       "Import %d product(s)? (y/n): ",
+	"Linha %d: ⚠️  já cadastrado: %s | %s | %dx | %s\n":                     "Line %d: ⚠️  already registered: %s | %s | %dx | %s\n",
+	"Linha %d: ✅ %s | %s | %dx | %s\n":                                      "Line %d: ✅ %s | %s | %dx | %s\n",
+	"Linha %d: ❌ %s\n":                                                      "Line %d: ❌ %s\n",
+	"Nenhum produto para importar.":                                         "No products to import.",
+	"✅ %d produto(s) exportado(s) para %s\n":                                "✅ %d product(s) exported to %s\n",
+	"✅ %d produto(s) importado(s)!\n":                                       "✅ %d product(s) imported!\n",
+	"Cartão (opcional, Enter para deixar em branco): ":                      "Card (optional, Enter to leave blank): ",
+	"Cartão atual: %s. Novo cartão (ou Enter para manter, - para limpar): ": "Current card: %s. New card (or Enter to keep, - to clear): ",
+	"Cartão: %s → %s":                                                       "Card: %s → %s",
+	"sem cartão":                                                            "no card",
+
+	// OFX statements
+	" (já paga)":                          " (already paid)",
+	" CONCILIAÇÃO DO EXTRATO ":            " STATEMENT RECONCILIATION ",
+	" → %s, parcela %d/%d":                " → %s, installment %d/%d",
+	" → '%s' em %dx de %s, parcela %d/%d": " → '%s' in %d installments of %s, installment %d/%d",
+	" ✔ paga":                             " ✔ paid",
+	"Aplicar a conciliação? (s/n): ":      "Apply the reconciliation? (y/n): ",
+	"Cartão deste extrato (opcional, Enter para deixar em branco): ": "Card of this statement (optional, Enter to leave blank): ",
+	"Erro ao ler o extrato:":                                "Error reading the statement:",
+	"Extrato OFX a importar (0 para voltar): ":              "OFX statement to import (0 to go back): ",
+	"Nada a atualizar.":                                     "Nothing to update.",
+	"Parcela %d/%d não paga":                                "Installment %d/%d unpaid",
+	"Parcela %d/%d paga":                                    "Installment %d/%d paid",
+	"\n✅ Conciliadas (%d):\n":                               "\n✅ Matched (%d):\n",
+	"\n❔ Não conciliadas (%d):\n":                           "\n❔ Unmatched (%d):\n",
+	"\n🆕 Produtos novos (%d):\n":                            "\n🆕 New products (%d):\n",
+	"\n⚠️  Ambíguas, com nome diferente do produto (%d):\n": "\n⚠️  Ambiguous, with a name different from the product (%d):\n",
+	" → talvez %s, parcela %d/%d":                           " → maybe %s, installment %d/%d",
+	"marcar parcela %d de '%s' como paga":                   "mark installment %d of '%s' as paid",
+	"✅ Extrato conciliado!":                                 "✅ Statement reconciled!",
+
+	// Ledger export
+	"Arquivo do diário de destino (0 para voltar): ": "Destination journal file (0 to go back): ",
+	"✅ Diário exportado para %s\n":                   "✅ Journal exported to %s\n",
+
+	// Calendar
+	"Arquivo .ics de destino (0 para voltar): ":       "Destination .ics file (0 to go back): ",
+	"1. Um evento por parcela":                        "1. One event per installment",
+	"2. Um evento por vencimento de fatura":           "2. One event per statement due date",
+	"Dia de vencimento das faturas (Enter para %d): ": "Statement due day (Enter for %d): ",
+	"Dia inválido. Use um número de 1 a 31.":          "Invalid day. Use a number from 1 to 31.",
+	"✅ %d evento(s) exportado(s) para %s\n":           "✅ %d event(s) exported to %s\n",
+
+	// Chart
+	"Quantos meses mostrar (%d a %d, Enter para %d): ":                 "How many months to show (%d to %d, Enter for %d): ",
+	"Quantidade de meses inválida.":                                    "Invalid number of months.",
+	" PARCELAS DOS PRÓXIMOS MESES (%02d/%d a %02d/%d) ":                " INSTALLMENTS FOR THE NEXT MONTHS (%02d/%d to %02d/%d) ",
+	"%s parcelas  %s acima do limite  %s limite: %s%% do lucro (%s)\n": "%s installments  %s over the limit  %s limit: %s%% of profit (%s)\n",
+	"✅ Nenhum mês acima da porcentagem segura.":                        "✅ No month over the safe percentage.",
+	"⚠️  %d mês(es) acima da porcentagem segura.\n":                    "⚠️  %d month(s) over the safe percentage.\n",
+
+	// Alerts
+	" ALERTAS ":          " ALERTS ",
+	"1. Adicionar regra": "1. Add rule",
+	"2. Remover regra":   "2. Remove rule",
+	"1. Uso do lucro acima de uma porcentagem nos próximos meses": "1. Profit usage above a percentage in the next months",
+	"2. Compra única acima de uma porcentagem do lucro":           "2. Single purchase above a percentage of the profit",
+	"3. Muitos produtos ativos em um cartão":                      "3. Too many active products on a card",
+	"Tipo da regra: ":                                          "Rule type: ",
+	"Porcentagem do lucro: ":                                   "Percentage of the profit: ",
+	"Quantidade de meses a verificar (1 a %d): ":               "Number of months to check (1 to %d): ",
+	"Cartão: ":                                                 "Card: ",
+	"Máximo de produtos ativos: ":                              "Maximum active products: ",
+	"Número da regra a remover: ":                              "Number of the rule to remove: ",
+	"Regra não encontrada.":                                    "Rule not found.",
+	"Nenhuma regra de alerta cadastrada.":                      "No alert rules registered.",
+	"Erro ao carregar os alertas:":                             "Error loading the alerts:",
+	"Erro ao salvar os alertas:":                               "Error saving the alerts:",
+	"✅ Alertas atualizados!":                                   "✅ Alerts updated!",
+	"🔔 %d ALERTA(S)\n":                                         "🔔 %d ALERT(S)\n",
+	"Uso acima de %s%% em algum dos próximos %d mês(es)":       "Usage above %s%% in any of the next %d month(s)",
+	"Compra única acima de %s%% do lucro mensal":               "Single purchase above %s%% of the monthly profit",
+	"Mais de %d produto(s) ativo(s) no cartão %s":              "More than %d active product(s) on card %s",
+	"As parcelas usam %s%% do lucro em %02d/%d (%s).":          "Installments use %s%% of the profit in %02d/%d (%s).",
+	"A compra '%s' (%s) equivale a %s%% do lucro mensal (%s).": "The purchase '%s' (%s) is %s%% of the monthly profit (%s).",
+	"O cartão %s tem %d produto(s) ativo(s) (%s).":             "Card %s has %d active product(s) (%s).",
+
+	// Split purchases
+	" DIVISÃO DE COMPRAS ":             " SPLIT PURCHASES ",
+	"1. Pessoas e rendas":              "1. People and incomes",
+	"2. Dividir produto":               "2. Split product",
+	"3. Resumo do mês por pessoa":      "3. Month summary per person",
+	"4. Quem deve a quem":              "4. Who owes whom",
+	" PESSOAS ":                        " PEOPLE ",
+	"%s (titular) | Renda: %s\n":       "%s (holder) | Income: %s\n",
+	"%s | Renda: %s\n":                 "%s | Income: %s\n",
+	"1. Adicionar ou atualizar pessoa": "1. Add or update person",
+	"2. Remover pessoa":                "2. Remove person",
+	"Nome: ":                           "Name: ",
+	"Renda mensal: ":                   "Monthly income: ",
+	"✅ Pessoas atualizadas!":           "✅ People updated!",
+	" DIVIDIR PRODUTO ":                " SPLIT PRODUCT ",
+	"Cadastre antes as pessoas que dividem as compras.":    "First register the people who share the purchases.",
+	"\nDivisão atual: %s\n":                                "\nCurrent split: %s\n",
+	"Pessoas: %s\n":                                        "People: %s\n",
+	"Quem pagou? (Enter para %s): ":                        "Who paid? (Enter for %s): ",
+	"Informe as partes; o que sobrar fica com quem pagou.": "Enter the shares; whatever is left stays with whoever paid.",
+	"Pessoa (Enter para terminar): ":                       "Person (Enter to finish): ",
+	"Parte de %s (ex.: 50%% ou 120,00): ":                  "Share of %s (e.g. 50%% or 120.00): ",
+	"✅ Divisão atualizada: %s\n":                           "✅ Split updated: %s\n",
+	"%s: %s por parcela\n":                                 "%s: %s per installment\n",
+	"\nSelecione a pessoa (0 para voltar):":                "\nSelect the person (0 to go back):",
+	"Pessoa: ":                                             "Person: ",
+	"Quantos meses a partir deste (Enter para 1): ":        "How many months from this one (Enter for 1): ",
+	" QUEM DEVE A QUEM ":                                   " WHO OWES WHOM ",
+	"Nenhuma compra dividida no período.":                  "No split purchases in the period.",
+	"%s | Pagou: %s | Parte: %s | Saldo: %s\n":             "%s | Paid: %s | Share: %s | Balance: %s\n",
+	"💸 %s deve %s a %s\n":                                  "💸 %s owes %s to %s\n",
+	"Ninguém deve nada.":                                   "Nobody owes anything.",
+	"não dividido":                                         "not split",
+	"pago por %s":                                          "paid by %s",
+	" | Sua parte: %s":                                     " | Your share: %s",
+	"Pessoa: %s\n":                                         "Person: %s\n",
+	"%d. %s | Total: %s | Parcela: %s (%d/%d)%s%s\n":       "%d. %s | Total: %s | Installment: %s (%d/%d)%s%s\n",
+	"Divisão: %s → %s":                                     "Split: %s → %s",
+	"Eu":                                                   "Me",
+	"3. Renomear titular":                                  "3. Rename holder",
+	"Nome do titular: ":                                    "Holder's name: ",
+	"adicionar pessoa '%s'":                                "add person '%s'",
+	"remover pessoa '%s'":                                  "remove person '%s'",
+	"renda de '%s' %s → %s":                                "income of '%s' %s → %s",
+	"titular '%s' → '%s'":                                  "holder '%s' → '%s'",
+
+	// Reminders
+	"Parcelas a vencer nos próximos %d dia(s):\n": "Installments due in the next %d day(s):\n",
+	"Total: %s\n":                           "Total: %s\n",
+	"Alertas:\n":                            "Alerts:\n",
+	"%d parcela(s) a vencer e %d alerta(s)": "%d installment(s) due and %d alert(s)",
+	"%d parcela(s) a vencer":                "%d installment(s) due",
+	"%d alerta(s)":                          "%d alert(s)",
+	"Gestor de Gastos (%s): %s":             "Spending Checker (%s): %s",
+
+	// history
+	"%s (removido)":                   "%s (removed)",
+	"ID do produto (0 para voltar): ": "Product ID (0 to go back): ",
+
+	// ics
+	"Parcela %d de %d: %s":         "Installment %d of %d: %s",
+	"Valor total: %s":              "Total value: %s",
+	"Valor original: %s":           "Original value: %s",
+	"Cartão: %s":                   "Card: %s",
+	"Categoria: %s":                "Category: %s",
+	" (paga)":                      " (paid)",
+	"Fatura %s":                    "%s statement",
+	"Parcelas sem cartão":          "Installments without a card",
+	"Total: %s":                    "Total: %s",
+	"Gestor Inteligente de Gastos": "Smart Spending Checker",
+
+	// ledger
+	"Lucro mensal": "Monthly profit",
+	"original: %s %s, cotação %s, IOF %s%%": "original: %s %s, rate %s, IOF %s%%",
+
+	// Errors
+	"nome inválido":                                                             "invalid name",
+	"valor inválido":                                                            "invalid value",
+	"número de parcelas inválido":                                               "invalid number of installments",
+	"porcentagem inválida: use um valor entre 0 e 100":                          "invalid percentage: use a value between 0 and 100",
+	"produto não encontrado":                                                    "product not found",
+	"evento não encontrado no histórico":                                        "event not found in history",
+	"nada para desfazer":                                                        "nothing to undo",
+	"nada para refazer":                                                         "nothing to redo",
+	"os dados foram modificados por outra sessão":                               "the data was changed by another session",
+	"arquivo de dados bloqueado por outra sessão":                               "data file locked by another session",
+	"perfil criptografado: senha necessária":                                    "encrypted profile: passphrase required",
+	"senha incorreta":                                                           "wrong passphrase",
+	"perfil não está criptografado":                                             "profile is not encrypted",
+	"perfil já está criptografado":                                              "profile is already encrypted",
+	"a senha não pode ser vazia":                                                "the passphrase cannot be empty",
+	"nome de perfil inválido":                                                   "invalid profile name",
+	"perfil já existe":                                                          "profile already exists",
+	"a interface em tela cheia precisa de um terminal interativo":               "the full-screen interface needs an interactive terminal",
+	"idioma desconhecido: use pt-BR, en-US ou es":                               "unknown language: use pt-BR, en-US or es",
+	"cotação inválida":                                                          "invalid rate",
+	"moeda inválida: use um código de três letras, como USD":                    "invalid currency: use a three-letter code such as USD",
+	"moeda sem cotação na tabela":                                               "currency has no rate in the table",
+	"IOF inválido: use um valor entre 0 e 100":                                  "invalid IOF: use a value between 0 and 100",
+	"arquivo CSV vazio":                                                         "empty CSV file",
+	"coluna obrigatória ausente no cabeçalho":                                   "required column missing from the header",
+	"data de compra inválida: use AAAA-MM-DD ou DD/MM/AAAA":                     "invalid purchase date: use YYYY-MM-DD or DD/MM/YYYY",
+	"nenhuma transação encontrada no arquivo OFX":                               "no transactions found in the OFX file",
+	"parcela já marcada como paga":                                              "installment already marked as paid",
+	"corpo da requisição inválido":                                              "invalid request body",
+	"parâmetro inválido":                                                        "invalid parameter",
+	"token de acesso inválido":                                                  "invalid access token",
+	"tipo de alerta inválido":                                                   "invalid alert type",
+	"quantidade de meses inválida":                                              "invalid number of months",
+	"quantidade de produtos inválida":                                           "invalid number of products",
+	"cartão inválido":                                                           "invalid card",
+	"nenhum meio de notificação configurado":                                    "no notification hook configured",
+	"configuração de SMTP inválida":                                             "invalid SMTP configuration",
+	"pessoa não encontrada":                                                     "person not found",
+	"pessoa usada na divisão de algum produto":                                  "person is used in the split of a product",
+	"a renda do titular é o lucro mensal do perfil":                             "the holder's income is the profile's monthly profit",
+	"parte inválida: use uma porcentagem entre 0 e 100 ou um valor positivo":    "invalid share: use a percentage between 0 and 100 or a positive amount",
+	"pessoa repetida na divisão":                                                "person repeated in the split",
+	"as partes somam mais que o valor total do produto":                         "the shares add up to more than the product's total value",
+	"já existe uma pessoa com esse nome":                                        "a person with that name already exists",
+	"a moeda de um produto não pode ser alterada":                               "a product's currency cannot be changed",
+	"o corpo da requisição deve ser application/json":                           "the request body must be application/json",
+	"origem não permitida":                                                      "origin not allowed",
+	"host não permitido sem token de acesso":                                    "host not allowed without an access token",
+	"histórico de alterações adulterado ou corrompido":                          "change history tampered with or corrupted",
+	"valor ambíguo: use duas casas decimais ou o separador de milhar do idioma": "ambiguous amount: use two decimal places or the language's thousands separator",
+}
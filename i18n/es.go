@@ -0,0 +1,487 @@
+package i18n
+
+var es = map[string]string{
+	// Main menu
+	" Gestor Inteligente de Gastos ":   " Gestor Inteligente de Gastos ",
+	" MENU PRINCIPAL":                  " MENÚ PRINCIPAL",
+	"Perfil: %s\n":                     "Perfil: %s\n",
+	"🕒 Visualizando como em %02d/%d\n": "🕒 Viendo como en %02d/%d\n",
+	"\nPor favor, defina seu lucro mensal antes de adicionar produtos.": "\nPor favor, define tu ganancia mensual antes de agregar productos.",
+	"1. Adicionar produto":             "1. Agregar producto",
+	"2. Remover produto":               "2. Eliminar producto",
+	"3. Listar meses":                  "3. Listar meses",
+	"4. Atualizar lucro mensal":        "4. Actualizar ganancia mensual",
+	"5. Editar produto":                "5. Editar producto",
+	"6. Antecipar parcelas":            "6. Adelantar cuotas",
+	"7. Configurar porcentagem segura": "7. Configurar porcentaje seguro",
+	"Desfazer":                         "Deshacer",
+	"Refazer":                          "Rehacer",
+	"8. Perfis":                        "8. Perfiles",
+	"9. Criptografia dos dados":        "9. Cifrado de datos",
+	"10. Histórico de produto":         "10. Historial de producto",
+	"11. Buscar produto":               "11. Buscar producto",
+	"12. Idioma":                       "12. Idioma",
+	"13. Câmbio":                       "13. Tipo de cambio",
+	"14. Importar/exportar":            "14. Importar/exportar",
+	"15. Gráfico dos próximos meses":   "15. Gráfico de los próximos meses",
+	"16. Alertas":                      "16. Alertas",
+	"17. Divisão de compras":           "17. División de compras",
+	"0. Sair":                          "0. Salir",
+	"0. Voltar ao Menu":                "0. Volver al Menú",
+	"Escolha uma opcão: ":              "Elige una opción: ",
+	"Opcão inválida.":                  "Opción inválida.",
+	"Saindo...":                        "Saliendo...",
+	"Erro ao carregar os dados:":       "Error al cargar los datos:",
+	"Erro ao salvar os dados:":         "Error al guardar los datos:",
+	"Erro ao salvar os dados: ":        "Error al guardar los datos: ",
+	"\n⚠️  Os dados deste perfil foram modificados em outra sessão.":                     "\n⚠️  Los datos de este perfil fueron modificados en otra sesión.",
+	"Recarregar e descartar suas alterações (r) ou mesclá-las com os dados atuais (m)? ": "¿Recargar y descartar tus cambios (r) o combinarlos con los datos actuales (m)? ",
+	"✅ Dados recarregados.": "✅ Datos recargados.",
+	"⚠️  %d alteração(ões) não puderam ser aplicadas e foram descartadas.\n": "⚠️  %d cambio(s) no se pudieron aplicar y fueron descartados.\n",
+	"✅ Alterações mescladas.": "✅ Cambios combinados.",
+
+	// Summary
+	" RESUMO DO MÊS (%02d/%d - %s) ":                                              " RESUMEN DEL MES (%02d/%d - %s) ",
+	"Lucro mensal: %s\n":                                                          "Ganancia mensual: %s\n",
+	"Total de parcelas: %s\n":                                                     "Total de cuotas: %s\n",
+	"Usado: %s%% | Para reinvestir: %s%% (%s)\n":                                  "Usado: %s%% | Para reinvertir: %s%% (%s)\n",
+	"Usado: %s%% | Para reinvestir: %s%%\n":                                       "Usado: %s%% | Para reinvertir: %s%%\n",
+	"Disponível para gastos: %s%% (%s) | Restante: %s\n":                          "Disponible para gastos: %s%% (%s) | Restante: %s\n",
+	"✅ Você pode usar parte do seu lucro para pagar as parcelas!":                 "✅ ¡Puedes usar parte de tu ganancia para pagar las cuotas!",
+	"❌ Não recomendado. Crie uma caixinha separada para alguns produtos!":         "❌ No recomendado. ¡Crea un ahorro aparte para algunos productos!",
+	"💡 Sugestão: Separe o produto '%s' (Parcela: %s) em uma caixinha separada.\n": "💡 Sugerencia: Separa el producto '%s' (Cuota: %s) en un ahorro aparte.\n",
+	"💡 Sugestão: Separe os seguintes produtos em uma caixinha:":                   "💡 Sugerencia: Separa los siguientes productos en un ahorro aparte:",
+	"  %d. %s (Parcela: %s)\n":                                                    "  %d. %s (Cuota: %s)\n",
+	"  Total a separar: %s\n":                                                     "  Total a separar: %s\n",
+	" PRODUTOS ATIVOS NESTE MÊS ":                                                 " PRODUCTOS ACTIVOS ESTE MES ",
+	"%d. %s | Total: %s | Parcela: %s (%d/%d)%s | Adicionado em: %s\n":            "%d. %s | Total: %s | Cuota: %s (%d/%d)%s | Agregado el: %s\n",
+	"%d. %s | Total: %s | Parcela: %s (%d/%d)%s\n":                                "%d. %s | Total: %s | Cuota: %s (%d/%d)%s\n",
+	"Nenhum produto ativo para %s de %d.\n":                                       "Ningún producto activo para %s de %d.\n",
+
+	// Months
+	" LISTAR MESES ":                            " LISTAR MESES ",
+	" PRODUTOS DE %s/%d ":                       " PRODUCTOS DE %s/%d ",
+	"\nNenhum produto encontrado para %s/%d.\n": "\nNingún producto encontrado para %s/%d.\n",
+	"\nSelecione o ano (0 para voltar):":        "\nSelecciona el año (0 para volver):",
+	"\nSelecione o mês (0 para voltar):":        "\nSelecciona el mes (0 para volver):",
+	"\nSelecione o produto (0 para voltar):":    "\nSelecciona el producto (0 para volver):",
+	"Ano: ":                                     "Año: ",
+	"Mês: ":                                     "Mes: ",
+	"Produto: ":                                 "Producto: ",
+	"Ano inválido.":                             "Año inválido.",
+	"Mês inválido.":                             "Mes inválido.",
+	"Produto inválido.":                         "Producto inválido.",
+	"%d. %s | Total: %s | Parcelas: %d | Adicionado em: %s\n": "%d. %s | Total: %s | Cuotas: %d | Agregado el: %s\n",
+	"\nPressione Enter para voltar...":                        "\nPresiona Enter para volver...",
+
+	// Products
+	" ADICIONAR PRODUTO ":                                               " AGREGAR PRODUCTO ",
+	" REMOVER PRODUTO ":                                                 " ELIMINAR PRODUCTO ",
+	" EDITAR PRODUTO ":                                                  " EDITAR PRODUCTO ",
+	" ANTECIPAR PARCELAS ":                                              " ADELANTAR CUOTAS ",
+	"Nome do produto (0 para voltar): ":                                 "Nombre del producto (0 para volver): ",
+	"Nome invalido.":                                                    "Nombre inválido.",
+	"Valor total do produto (R$) (0 para voltar): ":                     "Valor total del producto (R$) (0 para volver): ",
+	"Valor invalido.":                                                   "Valor inválido.",
+	"Em quantas vezes será parcelado (0 para voltar): ":                 "En cuántas cuotas se pagará (0 para volver): ",
+	"Número de parcelas inválido.":                                      "Número de cuotas inválido.",
+	"Categoria (opcional, Enter para deixar em branco): ":               "Categoría (opcional, Enter para dejar en blanco): ",
+	"✅ Produto adicionado! Parcela mensal: %s\n":                        "✅ ¡Producto agregado! Cuota mensual: %s\n",
+	"Nenhum produto para remover.":                                      "Ningún producto para eliminar.",
+	"\nTem certeza que deseja remover '%s'? (s/n): ":                    "\n¿Seguro que deseas eliminar '%s'? (s/n): ",
+	"Operação cancelada.":                                               "Operación cancelada.",
+	"✅ Produto removido!":                                               "✅ ¡Producto eliminado!",
+	"Nenhum produto para editar.":                                       "Ningún producto para editar.",
+	"Nome atual: %s. Novo nome (ou Enter para manter, 0 para voltar): ": "Nombre actual: %s. Nuevo nombre (o Enter para mantener, 0 para volver): ",
+	"Valor total atual: %s. Novo valor (ou Enter para manter, 0 para voltar): ":            "Valor total actual: %s. Nuevo valor (o Enter para mantener, 0 para volver): ",
+	"Parcelas atuais: %d. Novo número de parcelas (ou Enter para manter, 0 para voltar): ": "Cuotas actuales: %d. Nuevo número de cuotas (o Enter para mantener, 0 para volver): ",
+	"Categoria atual: %s. Nova categoria (ou Enter para manter, - para limpar): ":          "Categoría actual: %s. Nueva categoría (o Enter para mantener, - para borrar): ",
+	"✅ Produto atualizado!":                                                    "✅ ¡Producto actualizado!",
+	"Nenhum produto para antecipar parcelas.":                                  "Ningún producto para adelantar cuotas.",
+	"Este produto já foi totalmente pago.":                                     "Este producto ya fue pagado por completo.",
+	"Quantas parcelas deseja antecipar? (Total restante: %d, 0 para voltar): ": "¿Cuántas cuotas deseas adelantar? (Restantes: %d, 0 para volver): ",
+	"Quantidade inválida.":                                                     "Cantidad inválida.",
+	"Valor total para antecipar %d parcelas: %s\n":                             "Total para adelantar %d cuotas: %s\n",
+	"Deseja confirmar a antecipação? (s/n): ":                                  "¿Confirmar el adelanto? (s/n): ",
+	"✅ Parcelas antecipadas com sucesso!":                                      "✅ ¡Cuotas adelantadas con éxito!",
+
+	// Profit and safe percentage
+	" ATUALIZAR LUCRO MENSAL ":                 " ACTUALIZAR GANANCIA MENSUAL ",
+	"Novo lucro mensal (R$) (0 para voltar): ": "Nueva ganancia mensual (R$) (0 para volver): ",
+	"✅ Lucro mensal atualizado!":               "✅ ¡Ganancia mensual actualizada!",
+	" CONFIGURAR PORCENTAGEM SEGURA ":          " CONFIGURAR PORCENTAJE SEGURO ",
+	"A porcentagem segura define quanto do seu lucro mensal deve estar disponível para reinvestimento.": "El porcentaje seguro define cuánto de tu ganancia mensual debe quedar disponible para reinvertir.",
+	"Recomendação: Mantenha pelo menos 70% do seu lucro disponível para reinvestimento.":                "Recomendación: Mantén al menos el 70% de tu ganancia disponible para reinvertir.",
+	"Porcentagem atual: %s%%\n":                                       "Porcentaje actual: %s%%\n",
+	"Nova porcentagem segura (ou Enter para manter, 0 para voltar): ": "Nuevo porcentaje seguro (o Enter para mantener, 0 para volver): ",
+	"Mantendo a porcentagem atual.":                                   "Manteniendo el porcentaje actual.",
+	"Valor inválido. Mantendo a porcentagem atual.":                   "Valor inválido. Manteniendo el porcentaje actual.",
+	"✅ Porcentagem segura atualizada para %s%%!\n":                    "✅ ¡Porcentaje seguro actualizado a %s%%!\n",
+	"Porcentagem segura configurada: %s%%\n":                          "Porcentaje seguro configurado: %s%%\n",
+
+	// Language
+	" IDIOMA ":       " IDIOMA ",
+	"✅ Idioma: %s\n": "✅ Idioma: %s\n",
+
+	// Undo, redo and history
+	"↩️  Desfeito: %s\n":              "↩️  Deshecho: %s\n",
+	"↪️  Refeito: %s\n":               "↪️  Rehecho: %s\n",
+	"adicionar '%s'":                  "agregar '%s'",
+	"remover '%s'":                    "eliminar '%s'",
+	"editar '%s'":                     "editar '%s'",
+	"antecipar %d parcela(s) de '%s'": "adelantar %d cuota(s) de '%s'",
+	"lucro mensal %s → %s":            "ganancia mensual %s → %s",
+	"porcentagem segura %s%% → %s%%":  "porcentaje seguro %s%% → %s%%",
+	"desfazer":                        "deshacer",
+	"refazer":                         "rehacer",
+	" HISTÓRICO DE PRODUTO ":          " HISTORIAL DE PRODUCTO ",
+	" HISTÓRICO DE '%s' \n":           " HISTORIAL DE '%s' \n",
+	"Cadastrado em: %s\n":             "Registrado el: %s\n",
+	"Nenhuma alteração registrada para este produto.": "Ningún cambio registrado para este producto.",
+	"Desfeito: ": "Deshecho: ",
+	"Refeito: ":  "Rehecho: ",
+	"Adicionado | Nome: %s | Total: %s | Parcelas: %d": "Agregado | Nombre: %s | Total: %s | Cuotas: %d",
+	"Removido | Nome: %s | Total: %s | Parcelas: %d":   "Eliminado | Nombre: %s | Total: %s | Cuotas: %d",
+	"Antecipação | Parcelas: %d → %d":                  "Adelanto | Cuotas: %d → %d",
+	"Nome: %s → %s":                                    "Nombre: %s → %s",
+	"Categoria: %s → %s":                               "Categoría: %s → %s",
+	"Total: %s → %s":                                   "Total: %s → %s",
+	"Parcelas: %d → %d":                                "Cuotas: %d → %d",
+	"Editado | ":                                       "Editado | ",
+	"Editado | Sem alterações":                         "Editado | Sin cambios",
+
+	// Profiles
+	" PERFIS ":                              " PERFILES ",
+	"Perfil atual: %s\n":                    "Perfil actual: %s\n",
+	"1. Trocar perfil":                      "1. Cambiar perfil",
+	"2. Criar perfil":                       "2. Crear perfil",
+	"3. Visão combinada":                    "3. Vista combinada",
+	"Erro ao listar perfis:":                "Error al listar perfiles:",
+	"\nSelecione o perfil (0 para voltar):": "\nSelecciona el perfil (0 para volver):",
+	" (atual)":                              " (actual)",
+	"Perfil: ":                              "Perfil: ",
+	"Perfil inválido.":                      "Perfil inválido.",
+	"\nNome do novo perfil (letras, números, - e _) (0 para voltar): ":           "\nNombre del nuevo perfil (letras, números, - y _) (0 para volver): ",
+	"Não foi possível criar o perfil:":                                           "No se pudo crear el perfil:",
+	"✅ Perfil '%s' criado!\n":                                                    "✅ ¡Perfil '%s' creado!\n",
+	"Erro ao salvar configuração:":                                               "Error al guardar la configuración:",
+	"✅ Perfil ativo: %s\n":                                                       "✅ Perfil activo: %s\n",
+	" VISÃO COMBINADA (%02d/%d - %s) ":                                           " VISTA COMBINADA (%02d/%d - %s) ",
+	"%s | 🔒 Perfil bloqueado\n":                                                  "%s | 🔒 Perfil bloqueado\n",
+	"%s | Erro ao carregar: %v\n":                                                "%s | Error al cargar: %v\n",
+	"%s | Lucro: %s | Parcelas: %s | Usado: %s%% | Produtos ativos: %d\n":        "%s | Ganancia: %s | Cuotas: %s | Usado: %s%% | Productos activos: %d\n",
+	"TOTAL | Lucro: %s | Parcelas: %s | Usado: %s%% | Produtos ativos: %d\n":     "TOTAL | Ganancia: %s | Cuotas: %s | Usado: %s%% | Productos activos: %d\n",
+	"✅ Somando todos os perfis, as parcelas estão dentro da porcentagem segura.": "✅ Sumando todos los perfiles, las cuotas están dentro del porcentaje seguro.",
+	"❌ Somando todos os perfis, as parcelas ultrapassam a porcentagem segura.":   "❌ Sumando todos los perfiles, las cuotas superan el porcentaje seguro.",
+
+	// Encryption
+	" CRIPTOGRAFIA DOS DADOS ":               " CIFRADO DE DATOS ",
+	"Perfil '%s': 🔒 criptografado\n":         "Perfil '%s': 🔒 cifrado\n",
+	"Perfil '%s': 🔓 sem criptografia\n":      "Perfil '%s': 🔓 sin cifrado\n",
+	"1. Ativar criptografia":                 "1. Activar cifrado",
+	"1. Trocar senha":                        "1. Cambiar contraseña",
+	"2. Desativar criptografia":              "2. Desactivar cifrado",
+	"Senha do perfil '%s' (0 para voltar): ": "Contraseña del perfil '%s' (0 para volver): ",
+	"Número máximo de tentativas atingido.":  "Número máximo de intentos alcanzado.",
+	"Nova senha (0 para voltar): ":           "Nueva contraseña (0 para volver): ",
+	"Confirme a nova senha: ":                "Confirma la nueva contraseña: ",
+	"A senha não pode ser vazia.":            "La contraseña no puede estar vacía.",
+	"As senhas não conferem.":                "Las contraseñas no coinciden.",
+	"\nSenha atual: ":                        "\nContraseña actual: ",
+	"Erro ao ler os dados:":                  "Error al leer los datos:",
+	"Erro ao ativar a criptografia:":         "Error al activar el cifrado:",
+	"Erro ao trocar a senha:":                "Error al cambiar la contraseña:",
+	"Erro ao desativar a criptografia:":      "Error al desactivar el cifrado:",
+	"✅ Criptografia ativada!":                "✅ ¡Cifrado activado!",
+	"✅ Senha alterada!":                      "✅ ¡Contraseña cambiada!",
+	"✅ Criptografia desativada.":             "✅ Cifrado desactivado.",
+	"\n⚠️  Guarde a senha em local seguro: sem ela não é possível recuperar os dados.": "\n⚠️  Guarda la contraseña en un lugar seguro: sin ella no es posible recuperar los datos.",
+	"\nOs dados voltarão a ser salvos em texto puro. Confirmar? (s/n): ":               "\nLos datos volverán a guardarse en texto plano. ¿Confirmar? (s/n): ",
+
+	// Search
+	" BUSCAR PRODUTO ":                                            " BUSCAR PRODUCTO ",
+	"Nenhum produto cadastrado.":                                  "Ningún producto registrado.",
+	"Buscar por nome, categoria ou valor (0 para voltar): ":       "Buscar por nombre, categoría o valor (0 para volver): ",
+	"Nenhum produto encontrado para '%s'.\n":                      "Ningún producto encontrado para '%s'.\n",
+	"%d produtos encontrados, mostrando os %d mais relevantes.\n": "%d productos encontrados, mostrando los %d más relevantes.\n",
+	"quitado":           "pagado",
+	"parcela %d/%d":     "cuota %d/%d",
+	"começa em %02d/%d": "comienza en %02d/%d",
+	"%d. %s | %s | Total: %s | Parcela: %s | %s | Adicionado em: %s\n": "%d. %s | %s | Total: %s | Cuota: %s | %s | Agregado el: %s\n",
+	"\nO que deseja fazer com '%s'?\n":                                 "\n¿Qué deseas hacer con '%s'?\n",
+	"1. Editar":                                                        "1. Editar",
+	"2. Remover":                                                       "2. Eliminar",
+	"3. Antecipar parcelas":                                            "3. Adelantar cuotas",
+	"sem categoria":                                                    "sin categoría",
+
+	// Full-screen mode
+	"%s Gestor Inteligente de Gastos %s  Perfil: %s": "%s Gestor Inteligente de Gastos %s  Perfil: %s",
+	"  🕒 Visualizando como em %02d/%d":               "  🕒 Viendo como en %02d/%d",
+	"%s%s de %d%s — %d produto(s) ativo(s)":          "%s%s de %d%s — %d producto(s) activo(s)",
+	"Nome":        "Nombre",
+	"Valor total": "Valor total",
+	"Parcelas":    "Cuotas",
+	"Parcela":     "Cuota",
+	"Nº":          "Nº",
+	"Total":       "Total",
+	"novo":        "nuevo",
+	"  Nenhum produto ativo neste mês. Pressione 'a' para adicionar.":         "  Ningún producto activo este mes. Presiona 'a' para agregar.",
+	"Lucro mensal: %s   Total de parcelas: %s   Pode gastar: %s":              "Ganancia mensual: %s   Total de cuotas: %s   Puedes gastar: %s",
+	"Usado: %6s%% [%s] limite de uso %s%% (porcentagem segura %s%%)":          "Usado: %6s%% [%s] límite de uso %s%% (porcentaje seguro %s%%)",
+	"✅ Dentro da porcentagem segura.":                                         "✅ Dentro del porcentaje seguro.",
+	"❌ Acima do limite: não é recomendado comprar mais parcelados neste mês.": "❌ Por encima del límite: no se recomienda comprar más en cuotas este mes.",
+	"Remover '%s'? (s/n)": "¿Eliminar '%s'? (s/n)",
+	"Editando ":           "Editando ",
+	"Tab/↑/↓ campo  Enter próximo/salvar  Backspace apagar  Esc cancelar":                                 "Tab/↑/↓ campo  Enter siguiente/guardar  Backspace borrar  Esc cancelar",
+	"←/→ mês  ↑/↓ produto  h hoje  a adicionar  Enter/e editar  d remover  u desfazer  r refazer  q sair": "←/→ mes  ↑/↓ producto  h hoy  a agregar  Enter/e editar  d eliminar  u deshacer  r rehacer  q salir",
+	"Erro: ":             "Error: ",
+	"Remoção cancelada.": "Eliminación cancelada.",
+	"Desfeito.":          "Deshecho.",
+	"Refeito.":           "Rehecho.",
+	"✅ Produto '%s' adicionado! Parcela mensal: %s": "✅ ¡Producto '%s' agregado! Cuota mensual: %s",
+	"✅ Produto '%s' removido!":                      "✅ ¡Producto '%s' eliminado!",
+
+	// Exchange rates
+	" CÂMBIO ":            " TIPO DE CAMBIO ",
+	"1. Definir cotação":  "1. Definir cotización",
+	"2. Remover moeda":    "2. Eliminar moneda",
+	"3. Definir IOF":      "3. Definir IOF",
+	"Atualizada em: %s\n": "Actualizada el: %s\n",
+	"Convertido: %s = %s (cotação %s, IOF %s%%)\n":                     "Convertido: %s = %s (cotización %s, IOF %s%%)\n",
+	"Cotação %s → BRL (0 para voltar): ":                               "Cotización %s → BRL (0 para volver): ",
+	"Cotação %s → BRL (Enter para usar %s da tabela, 0 para voltar): ": "Cotización %s → BRL (Enter para usar %s de la tabla, 0 para volver): ",
+	"Cotação em reais: ":                                               "Cotización en reales: ",
+	"Cotação inválida.":                                                "Cotización inválida.",
+	"Erro ao carregar a tabela de câmbio:":                             "Error al cargar la tabla de cambio:",
+	"Erro ao salvar a tabela de câmbio:":                               "Error al guardar la tabla de cambio:",
+	"IOF em porcentagem (0 para não cobrar): ":                         "IOF en porcentaje (0 para no cobrarlo): ",
+	"IOF: %s%%\n":                  "IOF: %s%%\n",
+	"Incluir IOF de %s%%? (s/n): ": "¿Incluir IOF de %s%%? (s/n): ",
+	"Moeda (Enter para BRL, ou um código como USD ou EUR): ": "Moneda (Enter para BRL, o un código como USD o EUR): ",
+	"Moeda (código como USD ou EUR): ":                       "Moneda (código como USD o EUR): ",
+	"Moeda a remover: ":                                      "Moneda a eliminar: ",
+	"Nenhuma cotação cadastrada.":                            "No hay cotizaciones registradas.",
+	"Valor total do produto (%s) (0 para voltar): ":          "Valor total del producto (%s) (0 para volver): ",
+	"✅ Tabela de câmbio atualizada!":                         "✅ ¡Tabla de cambio actualizada!",
+
+	// CSV import and export
+	" IMPORTAR/EXPORTAR ":                                                   " IMPORTAR/EXPORTAR ",
+	" PRÉVIA DA IMPORTAÇÃO ":                                                " VISTA PREVIA DE LA IMPORTACIÓN ",
+	"%d válido(s), %d duplicado(s), %d inválido(s).\n":                      "%d válido(s), %d duplicado(s), %d inválido(s).\n",
+	"1. Importar produtos (CSV)":                                            "1. Importar productos (CSV)",
+	"2. Exportar produtos (CSV)":                                            "2. Exportar productos (CSV)",
+	"3. Importar extrato OFX":                                               "3. Importar extracto OFX",
+	"4. Exportar diário hledger/ledger":                                     "4. Exportar diario hledger/ledger",
+	"5. Exportar calendário de vencimentos (.ics)":                          "5. Exportar calendario de vencimientos (.ics)",
+	"Arquivo CSV a importar (0 para voltar): ":                              "Archivo CSV a importar (0 para volver): ",
+	"Arquivo CSV de destino (0 para voltar): ":                              "Archivo CSV de destino (0 para volver): ",
+	"Cabeçalho do CSV:":                                                     "Encabezado del CSV:",
+	"Erro ao abrir o arquivo:":                                              "Error al abrir el archivo:",
+	"Erro ao exportar:":                                                     "Error al exportar:",
+	"Erro ao ler o CSV:":                                                    "Error al leer el CSV:",
+	"Importar %d produto(s)? (s/n): ":                                       "¿Importar %d producto(s)? (s/n): ",
+	"Linha %d: ⚠️  já cadastrado: %s | %s | %dx | %s\n":                     "Línea %d: ⚠️  ya registrado: %s | %s | %dx | %s\n",
+	"Linha %d: ✅ %s | %s | %dx | %s\n":                                      "Línea %d: ✅ %s | %s | %dx | %s\n",
+	"Linha %d: ❌ %s\n":                                                      "Línea %d: ❌ %s\n",
+	"Nenhum produto para importar.":                                         "Ningún producto para importar.",
+	"✅ %d produto(s) exportado(s) para %s\n":                                "✅ %d producto(s) exportado(s) a %s\n",
+	"✅ %d produto(s) importado(s)!\n":                                       "✅ ¡%d producto(s) importado(s)!\n",
+	"Cartão (opcional, Enter para deixar em branco): ":                      "Tarjeta (opcional, Enter para dejar en blanco): ",
+	"Cartão atual: %s. Novo cartão (ou Enter para manter, - para limpar): ": "Tarjeta actual: %s. Nueva tarjeta (o Enter para mantener, - para borrar): ",
+	"Cartão: %s → %s":                                                       "Tarjeta: %s → %s",
+	"sem cartão":                                                            "sin tarjeta",
+
+	// OFX statements
+	" (já paga)":                          " (ya pagada)",
+	" CONCILIAÇÃO DO EXTRATO ":            " CONCILIACIÓN DEL EXTRACTO ",
+	" → %s, parcela %d/%d":                " → %s, cuota %d/%d",
+	" → '%s' em %dx de %s, parcela %d/%d": " → '%s' en %d cuotas de %s, cuota %d/%d",
+	" ✔ paga":                             " ✔ pagada",
+	"Aplicar a conciliação? (s/n): ":      "¿Aplicar la conciliación? (s/n): ",
+	"Cartão deste extrato (opcional, Enter para deixar em branco): ": "Tarjeta de este extracto (opcional, Enter para dejar en blanco): ",
+	"Erro ao ler o extrato:":                                "Error al leer el extracto:",
+	"Extrato OFX a importar (0 para voltar): ":              "Extracto OFX a importar (0 para volver): ",
+	"Nada a atualizar.":                                     "Nada que actualizar.",
+	"Parcela %d/%d não paga":                                "Cuota %d/%d no pagada",
+	"Parcela %d/%d paga":                                    "Cuota %d/%d pagada",
+	"\n✅ Conciliadas (%d):\n":                               "\n✅ Conciliadas (%d):\n",
+	"\n❔ Não conciliadas (%d):\n":                           "\n❔ No conciliadas (%d):\n",
+	"\n🆕 Produtos novos (%d):\n":                            "\n🆕 Productos nuevos (%d):\n",
+	"\n⚠️  Ambíguas, com nome diferente do produto (%d):\n": "\n⚠️  Ambiguas, con un nombre distinto al del producto (%d):\n",
+	" → talvez %s, parcela %d/%d":                           " → quizás %s, cuota %d/%d",
+	"marcar parcela %d de '%s' como paga":                   "marcar la cuota %d de '%s' como pagada",
+	"✅ Extrato conciliado!":                                 "✅ ¡Extracto conciliado!",
+
+	// Ledger export
+	"Arquivo do diário de destino (0 para voltar): ": "Archivo del diario de destino (0 para volver): ",
+	"✅ Diário exportado para %s\n":                   "✅ Diario exportado a %s\n",
+
+	// Calendar
+	"Arquivo .ics de destino (0 para voltar): ":       "Archivo .ics de destino (0 para volver): ",
+	"1. Um evento por parcela":                        "1. Un evento por cuota",
+	"2. Um evento por vencimento de fatura":           "2. Un evento por vencimiento de resumen",
+	"Dia de vencimento das faturas (Enter para %d): ": "Día de vencimiento de los resúmenes (Enter para %d): ",
+	"Dia inválido. Use um número de 1 a 31.":          "Día inválido. Use un número del 1 al 31.",
+	"✅ %d evento(s) exportado(s) para %s\n":           "✅ %d evento(s) exportado(s) a %s\n",
+
+	// Chart
+	"Quantos meses mostrar (%d a %d, Enter para %d): ":                 "Cuántos meses mostrar (%d a %d, Enter para %d): ",
+	"Quantidade de meses inválida.":                                    "Cantidad de meses inválida.",
+	" PARCELAS DOS PRÓXIMOS MESES (%02d/%d a %02d/%d) ":                " CUOTAS DE LOS PRÓXIMOS MESES (%02d/%d a %02d/%d) ",
+	"%s parcelas  %s acima do limite  %s limite: %s%% do lucro (%s)\n": "%s cuotas  %s por encima del límite  %s límite: %s%% de la ganancia (%s)\n",
+	"✅ Nenhum mês acima da porcentagem segura.":                        "✅ Ningún mes por encima del porcentaje seguro.",
+	"⚠️  %d mês(es) acima da porcentagem segura.\n":                    "⚠️  %d mes(es) por encima del porcentaje seguro.\n",
+
+	// Alerts
+	" ALERTAS ":          " ALERTAS ",
+	"1. Adicionar regra": "1. Agregar regla",
+	"2. Remover regra":   "2. Eliminar regla",
+	"1. Uso do lucro acima de uma porcentagem nos próximos meses": "1. Uso de la ganancia por encima de un porcentaje en los próximos meses",
+	"2. Compra única acima de uma porcentagem do lucro":           "2. Compra única por encima de un porcentaje de la ganancia",
+	"3. Muitos produtos ativos em um cartão":                      "3. Demasiados productos activos en una tarjeta",
+	"Tipo da regra: ":                                          "Tipo de regla: ",
+	"Porcentagem do lucro: ":                                   "Porcentaje de la ganancia: ",
+	"Quantidade de meses a verificar (1 a %d): ":               "Cantidad de meses a verificar (1 a %d): ",
+	"Cartão: ":                                                 "Tarjeta: ",
+	"Máximo de produtos ativos: ":                              "Máximo de productos activos: ",
+	"Número da regra a remover: ":                              "Número de la regla a eliminar: ",
+	"Regra não encontrada.":                                    "Regla no encontrada.",
+	"Nenhuma regra de alerta cadastrada.":                      "Ninguna regla de alerta registrada.",
+	"Erro ao carregar os alertas:":                             "Error al cargar las alertas:",
+	"Erro ao salvar os alertas:":                               "Error al guardar las alertas:",
+	"✅ Alertas atualizados!":                                   "✅ ¡Alertas actualizadas!",
+	"🔔 %d ALERTA(S)\n":                                         "🔔 %d ALERTA(S)\n",
+	"Uso acima de %s%% em algum dos próximos %d mês(es)":       "Uso por encima del %s%% en alguno de los próximos %d mes(es)",
+	"Compra única acima de %s%% do lucro mensal":               "Compra única por encima del %s%% de la ganancia mensual",
+	"Mais de %d produto(s) ativo(s) no cartão %s":              "Más de %d producto(s) activo(s) en la tarjeta %s",
+	"As parcelas usam %s%% do lucro em %02d/%d (%s).":          "Las cuotas usan el %s%% de la ganancia en %02d/%d (%s).",
+	"A compra '%s' (%s) equivale a %s%% do lucro mensal (%s).": "La compra '%s' (%s) equivale al %s%% de la ganancia mensual (%s).",
+	"O cartão %s tem %d produto(s) ativo(s) (%s).":             "La tarjeta %s tiene %d producto(s) activo(s) (%s).",
+
+	// Split purchases
+	" DIVISÃO DE COMPRAS ":             " DIVISIÓN DE COMPRAS ",
+	"1. Pessoas e rendas":              "1. Personas e ingresos",
+	"2. Dividir produto":               "2. Dividir producto",
+	"3. Resumo do mês por pessoa":      "3. Resumen del mes por persona",
+	"4. Quem deve a quem":              "4. Quién le debe a quién",
+	" PESSOAS ":                        " PERSONAS ",
+	"%s (titular) | Renda: %s\n":       "%s (titular) | Ingreso: %s\n",
+	"%s | Renda: %s\n":                 "%s | Ingreso: %s\n",
+	"1. Adicionar ou atualizar pessoa": "1. Agregar o actualizar persona",
+	"2. Remover pessoa":                "2. Eliminar persona",
+	"Nome: ":                           "Nombre: ",
+	"Renda mensal: ":                   "Ingreso mensual: ",
+	"✅ Pessoas atualizadas!":           "✅ ¡Personas actualizadas!",
+	" DIVIDIR PRODUTO ":                " DIVIDIR PRODUCTO ",
+	"Cadastre antes as pessoas que dividem as compras.":    "Primero registra a las personas que dividen las compras.",
+	"\nDivisão atual: %s\n":                                "\nDivisión actual: %s\n",
+	"Pessoas: %s\n":                                        "Personas: %s\n",
+	"Quem pagou? (Enter para %s): ":                        "¿Quién pagó? (Enter para %s): ",
+	"Informe as partes; o que sobrar fica com quem pagou.": "Indica las partes; lo que sobre queda con quien pagó.",
+	"Pessoa (Enter para terminar): ":                       "Persona (Enter para terminar): ",
+	"Parte de %s (ex.: 50%% ou 120,00): ":                  "Parte de %s (ej.: 50%% o 120,00): ",
+	"✅ Divisão atualizada: %s\n":                           "✅ División actualizada: %s\n",
+	"%s: %s por parcela\n":                                 "%s: %s por cuota\n",
+	"\nSelecione a pessoa (0 para voltar):":                "\nSelecciona la persona (0 para volver):",
+	"Pessoa: ":                                             "Persona: ",
+	"Quantos meses a partir deste (Enter para 1): ":        "Cuántos meses a partir de este (Enter para 1): ",
+	" QUEM DEVE A QUEM ":                                   " QUIÉN LE DEBE A QUIÉN ",
+	"Nenhuma compra dividida no período.":                  "Ninguna compra dividida en el período.",
+	"%s | Pagou: %s | Parte: %s | Saldo: %s\n":             "%s | Pagó: %s | Parte: %s | Saldo: %s\n",
+	"💸 %s deve %s a %s\n":                                  "💸 %s le debe %s a %s\n",
+	"Ninguém deve nada.":                                   "Nadie debe nada.",
+	"não dividido":                                         "no dividido",
+	"pago por %s":                                          "pagado por %s",
+	" | Sua parte: %s":                                     " | Tu parte: %s",
+	"Pessoa: %s\n":                                         "Persona: %s\n",
+	"%d. %s | Total: %s | Parcela: %s (%d/%d)%s%s\n":       "%d. %s | Total: %s | Cuota: %s (%d/%d)%s%s\n",
+	"Divisão: %s → %s":                                     "División: %s → %s",
+	"Eu":                                                   "Yo",
+	"3. Renomear titular":                                  "3. Renombrar titular",
+	"Nome do titular: ":                                    "Nombre del titular: ",
+	"adicionar pessoa '%s'":                                "agregar persona '%s'",
+	"remover pessoa '%s'":                                  "eliminar persona '%s'",
+	"renda de '%s' %s → %s":                                "ingreso de '%s' %s → %s",
+	"titular '%s' → '%s'":                                  "titular '%s' → '%s'",
+
+	// Reminders
+	"Parcelas a vencer nos próximos %d dia(s):\n": "Cuotas que vencen en los próximos %d día(s):\n",
+	"Total: %s\n":                           "Total: %s\n",
+	"Alertas:\n":                            "Alertas:\n",
+	"%d parcela(s) a vencer e %d alerta(s)": "%d cuota(s) por vencer y %d alerta(s)",
+	"%d parcela(s) a vencer":                "%d cuota(s) por vencer",
+	"%d alerta(s)":                          "%d alerta(s)",
+	"Gestor de Gastos (%s): %s":             "Gestor de Gastos (%s): %s",
+
+	// history
+	"%s (removido)":                   "%s (eliminado)",
+	"ID do produto (0 para voltar): ": "ID del producto (0 para volver): ",
+
+	// ics
+	"Parcela %d de %d: %s":         "Cuota %d de %d: %s",
+	"Valor total: %s":              "Valor total: %s",
+	"Valor original: %s":           "Valor original: %s",
+	"Cartão: %s":                   "Tarjeta: %s",
+	"Categoria: %s":                "Categoría: %s",
+	" (paga)":                      " (pagada)",
+	"Fatura %s":                    "Resumen de tarjeta %s",
+	"Parcelas sem cartão":          "Cuotas sin tarjeta",
+	"Total: %s":                    "Total: %s",
+	"Gestor Inteligente de Gastos": "Gestor Inteligente de Gastos",
+
+	// ledger
+	"Lucro mensal": "Ganancia mensual",
+	"original: %s %s, cotação %s, IOF %s%%": "original: %s %s, cotización %s, IOF %s%%",
+
+	// Errors
+	"nome inválido":                                                             "nombre inválido",
+	"valor inválido":                                                            "valor inválido",
+	"número de parcelas inválido":                                               "número de cuotas inválido",
+	"porcentagem inválida: use um valor entre 0 e 100":                          "porcentaje inválido: usa un valor entre 0 y 100",
+	"produto não encontrado":                                                    "producto no encontrado",
+	"evento não encontrado no histórico":                                        "evento no encontrado en el historial",
+	"nada para desfazer":                                                        "nada para deshacer",
+	"nada para refazer":                                                         "nada para rehacer",
+	"os dados foram modificados por outra sessão":                               "los datos fueron modificados por otra sesión",
+	"arquivo de dados bloqueado por outra sessão":                               "archivo de datos bloqueado por otra sesión",
+	"perfil criptografado: senha necessária":                                    "perfil cifrado: se requiere contraseña",
+	"senha incorreta":                                                           "contraseña incorrecta",
+	"perfil não está criptografado":                                             "el perfil no está cifrado",
+	"perfil já está criptografado":                                              "el perfil ya está cifrado",
+	"a senha não pode ser vazia":                                                "la contraseña no puede estar vacía",
+	"nome de perfil inválido":                                                   "nombre de perfil inválido",
+	"perfil já existe":                                                          "el perfil ya existe",
+	"a interface em tela cheia precisa de um terminal interativo":               "la interfaz a pantalla completa necesita una terminal interactiva",
+	"idioma desconhecido: use pt-BR, en-US ou es":                               "idioma desconocido: usa pt-BR, en-US o es",
+	"cotação inválida":                                                          "cotización inválida",
+	"moeda inválida: use um código de três letras, como USD":                    "moneda inválida: use un código de tres letras, como USD",
+	"moeda sem cotação na tabela":                                               "moneda sin cotización en la tabla",
+	"IOF inválido: use um valor entre 0 e 100":                                  "IOF inválido: use un valor entre 0 y 100",
+	"arquivo CSV vazio":                                                         "archivo CSV vacío",
+	"coluna obrigatória ausente no cabeçalho":                                   "columna obligatoria ausente en el encabezado",
+	"data de compra inválida: use AAAA-MM-DD ou DD/MM/AAAA":                     "fecha de compra inválida: use AAAA-MM-DD o DD/MM/AAAA",
+	"nenhuma transação encontrada no arquivo OFX":                               "ninguna transacción encontrada en el archivo OFX",
+	"parcela já marcada como paga":                                              "cuota ya marcada como pagada",
+	"corpo da requisição inválido":                                              "cuerpo de la solicitud inválido",
+	"parâmetro inválido":                                                        "parámetro inválido",
+	"token de acesso inválido":                                                  "token de acceso inválido",
+	"tipo de alerta inválido":                                                   "tipo de alerta inválido",
+	"quantidade de meses inválida":                                              "cantidad de meses inválida",
+	"quantidade de produtos inválida":                                           "cantidad de productos inválida",
+	"cartão inválido":                                                           "tarjeta inválida",
+	"nenhum meio de notificação configurado":                                    "ningún medio de notificación configurado",
+	"configuração de SMTP inválida":                                             "configuración de SMTP inválida",
+	"pessoa não encontrada":                                                     "persona no encontrada",
+	"pessoa usada na divisão de algum produto":                                  "persona usada en la división de algún producto",
+	"a renda do titular é o lucro mensal do perfil":                             "el ingreso del titular es la ganancia mensual del perfil",
+	"parte inválida: use uma porcentagem entre 0 e 100 ou um valor positivo":    "parte inválida: usa un porcentaje entre 0 y 100 o un valor positivo",
+	"pessoa repetida na divisão":                                                "persona repetida en la división",
+	"as partes somam mais que o valor total do produto":                         "las partes suman más que el valor total del producto",
+	"já existe uma pessoa com esse nome":                                        "ya existe una persona con ese nombre",
+	"a moeda de um produto não pode ser alterada":                               "la moneda de un producto no se puede cambiar",
+	"o corpo da requisição deve ser application/json":                           "el cuerpo de la solicitud debe ser application/json",
+	"origem não permitida":                                                      "origen no permitido",
+	"host não permitido sem token de acesso":                                    "host no permitido sin token de acceso",
+	"histórico de alterações adulterado ou corrompido":                          "historial de cambios alterado o dañado",
+	"valor ambíguo: use duas casas decimais ou o separador de milhar do idioma": "valor ambiguo: usa dos decimales o el separador de miles del idioma",
+}
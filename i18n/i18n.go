@@ -0,0 +1,159 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	PtBR = "pt-BR"
+	EnUS = "en-US"
+	Es   = "es"
+)
+
+const Default = PtBR
+
+const Env = "SSC_LANG"
+
+var ErrUnknownLocale = errors.New("idioma desconhecido: use pt-BR, en-US ou es")
+
+var catalogs = map[string]map[string]string{
+	EnUS: enUS,
+	Es:   es,
+}
+
+var monthNames = map[string][]string{
+	PtBR: {"Janeiro", "Fevereiro", "Março", "Abril", "Maio", "Junho",
+		"Julho", "Agosto", "Setembro", "Outubro", "Novembro", "Dezembro"},
+	EnUS: {"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+	Es: {"Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio",
+		"Julio", "Agosto", "Septiembre", "Octubre", "Noviembre", "Diciembre"},
+}
+
+var yesAnswers = map[string][]string{
+	PtBR: {"s", "sim"},
+	EnUS: {"y", "yes"},
+	Es:   {"s", "si", "sí"},
+}
+
+var reloadAnswers = map[string][]string{
+	PtBR: {"r", "recarregar"},
+	EnUS: {"r", "reload"},
+	Es:   {"r", "recargar"},
+}
+
+var mergeAnswers = map[string][]string{
+	PtBR: {"m", "mesclar"},
+	EnUS: {"m", "merge"},
+	Es:   {"m", "combinar", "mezclar"},
+}
+
+var current = Default
+
+func Locales() []string {
+	return []string{PtBR, EnUS, Es}
+}
+
+func Normalize(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if i := strings.IndexAny(name, ".@"); i >= 0 {
+		name = name[:i]
+	}
+	name = strings.ReplaceAll(name, "_", "-")
+
+	switch {
+	case name == "pt" || strings.HasPrefix(name, "pt-"):
+		return PtBR, nil
+	case name == "en" || strings.HasPrefix(name, "en-"):
+		return EnUS, nil
+	case name == "es" || strings.HasPrefix(name, "es-"):
+		return Es, nil
+	}
+	return "", ErrUnknownLocale
+}
+
+func SetLocale(name string) error {
+	locale, err := Normalize(name)
+	if err != nil {
+		return err
+	}
+	current = locale
+	return nil
+}
+
+func Locale() string {
+	return current
+}
+
+func T(message string) string {
+	if translated, ok := catalogs[current][message]; ok {
+		return translated
+	}
+	return message
+}
+
+func Variants(message string) []string {
+	variants := []string{message}
+	for _, locale := range Locales() {
+		if translated, ok := catalogs[locale][message]; ok && !slices.Contains(variants, translated) {
+			variants = append(variants, translated)
+		}
+	}
+	return variants
+}
+
+func Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+func Error(err error) string {
+	message := err.Error()
+	if translated := T(message); translated != message {
+		return translated
+	}
+	if head, detail, ok := strings.Cut(message, ": "); ok {
+		return T(head) + ": " + detail
+	}
+	return message
+}
+
+func MonthName(month int) string {
+	return monthNames[current][month-1]
+}
+
+func IsYes(answer string) bool {
+	return isAnswer(yesAnswers, answer)
+}
+
+func IsReload(answer string) bool {
+	return isAnswer(reloadAnswers, answer)
+}
+
+func IsMerge(answer string) bool {
+	return isAnswer(mergeAnswers, answer)
+}
+
+func isAnswer(answers map[string][]string, answer string) bool {
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	for _, accepted := range answers[current] {
+		if answer == accepted {
+			return true
+		}
+	}
+	return false
+}
+
+func FormatDate(t time.Time) string {
+	if current == EnUS {
+		return t.Format("01/02/2006")
+	}
+	return t.Format("02/01/2006")
+}
+
+func FormatDateTime(t time.Time) string {
+	return FormatDate(t) + t.Format(" 15:04")
+}
@@ -0,0 +1,244 @@
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+type Mode string
+
+const (
+	ModeInstallments Mode = "parcelas"
+	ModeStatements   Mode = "faturas"
+)
+
+const (
+	DefaultMonths = 12
+	DefaultDueDay = 10
+	noCard        = "Sem cartão"
+	uidDomain     = "smart-spending-checker"
+	maxLineOctets = 75
+)
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+type Options struct {
+	Mode      Mode
+	From      time.Time
+	Months    int
+	DueDay    int
+	DueDays   map[string]int
+	AlarmDays int
+	Now       time.Time
+}
+
+type Event struct {
+	UID         string
+	Date        time.Time
+	Summary     string
+	Description string
+}
+
+func ParseMode(value string) (Mode, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", string(ModeInstallments), "installments":
+		return ModeInstallments, true
+	case string(ModeStatements), "statements":
+		return ModeStatements, true
+	}
+	return "", false
+}
+
+func Events(list product.ProductList, opts Options) []Event {
+	from := time.Date(opts.From.Year(), opts.From.Month(), opts.From.Day(), 0, 0, 0, 0, time.Local)
+	months := opts.Months
+	if months <= 0 {
+		months = DefaultMonths
+	}
+	end := time.Date(from.Year(), from.Month()+time.Month(months), 1, 0, 0, 0, 0, time.Local)
+
+	if opts.Mode == ModeStatements {
+		return statementEvents(list.Schedule(), opts, from, end)
+	}
+
+	var events []Event
+	for _, installment := range list.Schedule() {
+		p := installment.Product
+		if installment.Due.Before(from) || !installment.Due.Before(end) || p.IsPaid(installment.Number) {
+			continue
+		}
+
+		description := []string{
+			i18n.Sprintf("Parcela %d de %d: %s", installment.Number, p.Installments, money.Format(installment.Amount)),
+			i18n.Sprintf("Valor total: %s", money.Format(p.TotalValue)),
+		}
+		if p.IsForeign() {
+			description = append(description, i18n.Sprintf("Valor original: %s", money.FormatIn(p.OriginalValue, p.Currency)))
+		}
+		if p.Card != "" {
+			description = append(description, i18n.Sprintf("Cartão: %s", p.Card))
+		}
+		if p.Category != "" {
+			description = append(description, i18n.Sprintf("Categoria: %s", p.Category))
+		}
+
+		events = append(events, Event{
+			UID:         fmt.Sprintf("parcela-%d-%d@%s", p.ID, installment.Number, uidDomain),
+			Date:        installment.Due,
+			Summary:     fmt.Sprintf("%s (%d/%d): %s", p.Name, installment.Number, p.Installments, money.Format(installment.Amount)),
+			Description: strings.Join(description, "\n"),
+		})
+	}
+	return events
+}
+
+func statementEvents(installments []product.Installment, opts Options, from, end time.Time) []Event {
+	type statement struct {
+		card   string
+		due    time.Time
+		lines  []string
+		total  float64
+		unpaid bool
+	}
+	statements := make(map[string]*statement)
+
+	for _, installment := range installments {
+		p := installment.Product
+		card := strings.TrimSpace(p.Card)
+		if card == "" {
+			card = noCard
+		}
+		due := statementDue(installment.Year, installment.Month, dueDay(card, opts))
+		if due.Before(from) || !due.Before(end) {
+			continue
+		}
+
+		key := fmt.Sprintf("%s|%04d-%02d", strings.ToLower(card), installment.Year, installment.Month)
+		s, ok := statements[key]
+		if !ok {
+			s = &statement{card: card, due: due}
+			statements[key] = s
+		}
+
+		paid := ""
+		if p.IsPaid(installment.Number) {
+			paid = i18n.T(" (paga)")
+		} else {
+			s.unpaid = true
+		}
+		s.lines = append(s.lines, fmt.Sprintf("%s (%d/%d): %s%s", p.Name, installment.Number, p.Installments,
+			money.Format(installment.Amount), paid))
+		s.total += installment.Amount
+	}
+
+	var events []Event
+	for _, s := range statements {
+		if !s.unpaid {
+			continue
+		}
+		title := i18n.Sprintf("Fatura %s", s.card)
+		if s.card == noCard {
+			title = i18n.T("Parcelas sem cartão")
+		}
+		description := append([]string{i18n.Sprintf("Total: %s", money.Format(s.total))}, s.lines...)
+		events = append(events, Event{
+			UID:         fmt.Sprintf("fatura-%s-%s@%s", slug(s.card), s.due.Format("2006-01"), uidDomain),
+			Date:        s.due,
+			Summary:     fmt.Sprintf("%s: %s", title, money.Format(s.total)),
+			Description: strings.Join(description, "\n"),
+		})
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].Date.Equal(events[j].Date) {
+			return events[i].Date.Before(events[j].Date)
+		}
+		return events[i].UID < events[j].UID
+	})
+	return events
+}
+
+func dueDay(card string, opts Options) int {
+	for name, day := range opts.DueDays {
+		if strings.EqualFold(strings.TrimSpace(name), card) {
+			return day
+		}
+	}
+	if opts.DueDay > 0 {
+		return opts.DueDay
+	}
+	return DefaultDueDay
+}
+
+func statementDue(year, month, day int) time.Time {
+	lastDay := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.Local).Day()
+	return time.Date(year, time.Month(month), min(day, lastDay), 0, 0, 0, 0, time.Local)
+}
+
+func Export(w io.Writer, list product.ProductList, opts Options) error {
+	out := bufio.NewWriter(w)
+	stamp := opts.Now.UTC().Format("20060102T150405Z")
+
+	line(out, "BEGIN:VCALENDAR")
+	line(out, "VERSION:2.0")
+	line(out, "PRODID:-//smart-spending-checker//Gestor Inteligente de Gastos//PT")
+	line(out, "CALSCALE:GREGORIAN")
+	line(out, "METHOD:PUBLISH")
+	line(out, "X-WR-CALNAME:"+escape(i18n.T("Gestor Inteligente de Gastos")))
+
+	for _, event := range Events(list, opts) {
+		line(out, "BEGIN:VEVENT")
+		line(out, "UID:"+event.UID)
+		line(out, "DTSTAMP:"+stamp)
+		line(out, "DTSTART;VALUE=DATE:"+event.Date.Format("20060102"))
+		line(out, "DTEND;VALUE=DATE:"+event.Date.AddDate(0, 0, 1).Format("20060102"))
+		line(out, "SUMMARY:"+escape(event.Summary))
+		line(out, "DESCRIPTION:"+escape(event.Description))
+		line(out, "TRANSP:TRANSPARENT")
+		if opts.AlarmDays > 0 {
+			line(out, "BEGIN:VALARM")
+			line(out, "ACTION:DISPLAY")
+			line(out, "DESCRIPTION:"+escape(event.Summary))
+			line(out, fmt.Sprintf("TRIGGER:-P%dD", opts.AlarmDays))
+			line(out, "END:VALARM")
+		}
+		line(out, "END:VEVENT")
+	}
+
+	line(out, "END:VCALENDAR")
+	return out.Flush()
+}
+
+func line(out *bufio.Writer, text string) {
+	for len(text) > maxLineOctets {
+		cut := maxLineOctets
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		out.WriteString(text[:cut] + "\r\n")
+		text = " " + text[cut:]
+	}
+	out.WriteString(text + "\r\n")
+}
+
+func escape(text string) string {
+	return textEscaper.Replace(text)
+}
+
+func slug(text string) string {
+	return strings.Join(strings.Fields(strings.Map(func(r rune) rune {
+		if r == '-' || r == '@' || r == '|' {
+			return ' '
+		}
+		return r
+	}, strings.ToLower(text))), "-")
+}
@@ -0,0 +1,118 @@
+package ledger
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+const commodity = "BRL"
+
+type Options struct {
+	From               time.Time
+	To                 time.Time
+	ExpensesAccount    string
+	LiabilitiesAccount string
+	IncomeAccount      string
+	AssetsAccount      string
+}
+
+func DefaultOptions() Options {
+	return Options{
+		ExpensesAccount:    "despesas",
+		LiabilitiesAccount: "passivo:cartões",
+		IncomeAccount:      "receitas:lucro",
+		AssetsAccount:      "ativo:conta",
+	}
+}
+
+func Export(w io.Writer, list product.ProductList, opts Options) error {
+	out := bufio.NewWriter(w)
+	installments := list.Schedule()
+
+	from, to := opts.From, opts.To
+	if len(installments) > 0 {
+		if from.IsZero() {
+			first := installments[0].Due
+			from = time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, time.Local)
+		}
+		if to.IsZero() {
+			last := installments[len(installments)-1].Due
+			to = time.Date(last.Year(), last.Month(), 1, 0, 0, 0, 0, time.Local)
+		}
+	}
+	if to.IsZero() {
+		to = from
+	}
+	end := to.AddDate(0, 1, 0)
+
+	fmt.Fprintln(out, "; "+i18n.T("Gestor Inteligente de Gastos"))
+
+	for month := from; !from.IsZero() && month.Before(end); month = month.AddDate(0, 1, 0) {
+		if list.MonthlyProfit > 0 {
+			fmt.Fprintf(out, "\n%s %s\n", month.Format(time.DateOnly), i18n.T("Lucro mensal"))
+			posting(out, opts.AssetsAccount, list.MonthlyProfit)
+			fmt.Fprintf(out, "    %s\n", opts.IncomeAccount)
+		}
+
+		for _, installment := range installments {
+			if installment.Year != month.Year() || installment.Month != int(month.Month()) {
+				continue
+			}
+			writeInstallment(out, installment, opts)
+		}
+	}
+
+	return out.Flush()
+}
+
+func writeInstallment(out *bufio.Writer, installment product.Installment, opts Options) {
+	p := installment.Product
+
+	status := ""
+	if p.IsPaid(installment.Number) {
+		status = "* "
+	}
+	fmt.Fprintf(out, "\n%s %s%s (%s)\n", installment.Due.Format(time.DateOnly), status,
+		description(p.Name), i18n.Sprintf("parcela %d/%d", installment.Number, p.Installments))
+	fmt.Fprintf(out, "    ; id:%d\n", p.ID)
+	if p.IsForeign() {
+		fmt.Fprintf(out, "    ; "+i18n.T("original: %s %s, cotação %s, IOF %s%%")+"\n", amount(p.OriginalValue), p.Currency,
+			strconv.FormatFloat(p.ExchangeRate, 'f', -1, 64), strconv.FormatFloat(p.IOF, 'f', -1, 64))
+	}
+
+	posting(out, account(opts.ExpensesAccount, p.Category, "outros"), installment.Amount)
+	fmt.Fprintf(out, "    %s\n", account(opts.LiabilitiesAccount, p.Card, ""))
+}
+
+func posting(out *bufio.Writer, account string, value float64) {
+	fmt.Fprintf(out, "    %-40s  %s %s\n", account, amount(value), commodity)
+}
+
+func account(parent, name, fallback string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = fallback
+	}
+	if name == "" {
+		return parent
+	}
+
+	name = strings.Join(strings.Fields(strings.ReplaceAll(strings.ToLower(name), ":", " ")), " ")
+	return parent + ":" + name
+}
+
+func description(name string) string {
+	return strings.NewReplacer("|", "/", ";", ",", "\n", " ").Replace(strings.TrimSpace(name))
+}
+
+func amount(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', 2, 64)
+}
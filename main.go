@@ -1,7 +1,11 @@
 package main
 
-import "github.com/pedrorcruzz/smart-spending-checker/menu"
+import (
+	"os"
+
+	"github.com/pedrorcruzz/smart-spending-checker/cli"
+)
 
 func main() {
-	menu.ShowMenu()
+	os.Exit(cli.Run(os.Args[1:]))
 }
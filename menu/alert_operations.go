@@ -0,0 +1,152 @@
+package menu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/alert"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+func (s *session) showAlerts(profile string, list product.ProductList) {
+	rules, err := storage.LoadRules(profile)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao carregar os alertas:"), i18n.Error(err))
+		return
+	}
+
+	alerts := alert.Evaluate(list, rules, s.clock.Now())
+	if len(alerts) == 0 {
+		return
+	}
+
+	divider := strings.Repeat("!", 60)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintf(s.out, i18n.T("🔔 %d ALERTA(S)\n"), len(alerts))
+	for _, a := range alerts {
+		fmt.Fprintln(s.out, "• "+a.Message)
+	}
+	fmt.Fprintln(s.out, divider)
+}
+
+func (s *session) manageAlerts(profile string) {
+	title := i18n.T(" ALERTAS ")
+	divider := strings.Repeat("-", 40)
+
+	rules, err := storage.LoadRules(profile)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao carregar os alertas:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	if len(rules) == 0 {
+		fmt.Fprintln(s.out, i18n.T("Nenhuma regra de alerta cadastrada."))
+	}
+	for i, rule := range rules {
+		fmt.Fprintf(s.out, "%d. %s\n", i+1, rule.Describe())
+	}
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("1. Adicionar regra"))
+	fmt.Fprintln(s.out, i18n.T("2. Remover regra"))
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choice, _ := s.readLine()
+	choice = strings.TrimSpace(choice)
+
+	switch choice {
+	case "0":
+		return
+	case "1":
+		rule, ok := s.readRule()
+		if !ok {
+			return
+		}
+		rules = append(rules, rule)
+	case "2":
+		fmt.Fprint(s.out, i18n.T("Número da regra a remover: "))
+		numberStr, _ := s.readLine()
+		number, err := strconv.Atoi(strings.TrimSpace(numberStr))
+		if err != nil || number < 1 || number > len(rules) {
+			fmt.Fprintln(s.out, i18n.T("Regra não encontrada."))
+			s.pause(2 * time.Second)
+			return
+		}
+		rules = append(rules[:number-1], rules[number:]...)
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+		return
+	}
+
+	if err := storage.SaveRules(profile, rules); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao salvar os alertas:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+	fmt.Fprintln(s.out, i18n.T("✅ Alertas atualizados!"))
+	s.pause(2 * time.Second)
+}
+
+func (s *session) readRule() (alert.Rule, bool) {
+	fmt.Fprintln(s.out, i18n.T("1. Uso do lucro acima de uma porcentagem nos próximos meses"))
+	fmt.Fprintln(s.out, i18n.T("2. Compra única acima de uma porcentagem do lucro"))
+	fmt.Fprintln(s.out, i18n.T("3. Muitos produtos ativos em um cartão"))
+	fmt.Fprint(s.out, i18n.T("Tipo da regra: "))
+	kind, _ := s.readLine()
+
+	var rule alert.Rule
+	var err error
+	switch strings.TrimSpace(kind) {
+	case "1":
+		rule.Kind = alert.KindUsedPercent
+		if rule.Threshold, err = s.readFloat(i18n.T("Porcentagem do lucro: ")); err != nil {
+			break
+		}
+		if err = product.ValidateSafePercentage(rule.Threshold); err != nil {
+			break
+		}
+		fmt.Fprintf(s.out, i18n.T("Quantidade de meses a verificar (1 a %d): "), alert.MaxMonths)
+		monthsStr, _ := s.readLine()
+		if rule.Months, err = strconv.Atoi(strings.TrimSpace(monthsStr)); err != nil {
+			err = alert.ErrInvalidMonths
+		}
+	case "2":
+		rule.Kind = alert.KindSinglePurchase
+		rule.Threshold, err = s.readFloat(i18n.T("Porcentagem do lucro: "))
+	case "3":
+		rule.Kind = alert.KindCardProducts
+		fmt.Fprint(s.out, i18n.T("Cartão: "))
+		card, _ := s.readLine()
+		rule.Card = strings.TrimSpace(card)
+		fmt.Fprint(s.out, i18n.T("Máximo de produtos ativos: "))
+		countStr, _ := s.readLine()
+		count, convErr := strconv.Atoi(strings.TrimSpace(countStr))
+		if convErr != nil {
+			err = alert.ErrInvalidCount
+		}
+		rule.Threshold = float64(count)
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+		return rule, false
+	}
+
+	if err == nil {
+		err = rule.Validate()
+	}
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return rule, false
+	}
+	return rule, true
+}
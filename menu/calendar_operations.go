@@ -0,0 +1,68 @@
+package menu
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/ics"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+func (s *session) exportCalendar(list product.ProductList) {
+	fmt.Fprint(s.out, i18n.T("Arquivo .ics de destino (0 para voltar): "))
+	path, _ := s.readLine()
+	path = strings.TrimSpace(path)
+
+	if path == "0" || path == "" {
+		return
+	}
+
+	now := s.clock.Now()
+	opts := ics.Options{Mode: ics.ModeInstallments, From: now, Now: now, AlarmDays: 2}
+
+	fmt.Fprintln(s.out, i18n.T("1. Um evento por parcela"))
+	fmt.Fprintln(s.out, i18n.T("2. Um evento por vencimento de fatura"))
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choice, _ := s.readLine()
+
+	switch strings.TrimSpace(choice) {
+	case "1":
+	case "2":
+		opts.Mode = ics.ModeStatements
+		fmt.Fprintf(s.out, i18n.T("Dia de vencimento das faturas (Enter para %d): "), ics.DefaultDueDay)
+		dayText, _ := s.readLine()
+		if dayText = strings.TrimSpace(dayText); dayText != "" {
+			day, err := strconv.Atoi(dayText)
+			if err != nil || day < 1 || day > 31 {
+				fmt.Fprintln(s.out, i18n.T("Dia inválido. Use um número de 1 a 31."))
+				s.pause(2 * time.Second)
+				return
+			}
+			opts.DueDay = day
+		}
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+		return
+	}
+
+	f, err := os.Create(path)
+	if err == nil {
+		err = ics.Export(f, list, opts)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao exportar:"), err)
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintf(s.out, i18n.T("✅ %d evento(s) exportado(s) para %s\n"), len(ics.Events(list, opts)), path)
+	s.pause(2 * time.Second)
+}
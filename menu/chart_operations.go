@@ -0,0 +1,131 @@
+package menu
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+	"github.com/pedrorcruzz/smart-spending-checker/utils"
+)
+
+const (
+	chartWidth      = 40
+	chartMinMonths  = 12
+	chartMaxMonths  = 24
+	chartBar        = "█"
+	chartOverBar    = "▓"
+	chartLimit      = "┃"
+	chartColorRed   = "\x1b[31m"
+	chartColorReset = "\x1b[0m"
+)
+
+func (s *session) showChart(list product.ProductList) {
+	fmt.Fprintf(s.out, i18n.T("Quantos meses mostrar (%d a %d, Enter para %d): "), chartMinMonths, chartMaxMonths, chartMinMonths)
+	monthsStr, _ := s.readLine()
+	monthsStr = strings.TrimSpace(monthsStr)
+
+	months := chartMinMonths
+	if monthsStr != "" {
+		var err error
+		months, err = strconv.Atoi(monthsStr)
+		if err != nil || months < chartMinMonths || months > chartMaxMonths {
+			fmt.Fprintln(s.out, i18n.T("Quantidade de meses inválida."))
+			s.pause(2 * time.Second)
+			return
+		}
+	}
+
+	now := s.clock.Now()
+	s.clear()
+	s.renderChart(list, now.Year(), int(now.Month()), months, utils.IsTerminal(s.out))
+
+	fmt.Fprint(s.out, i18n.T("\nPressione Enter para voltar..."))
+	s.readLine()
+}
+
+func ShowChart(w io.Writer, list product.ProductList, year, month, months int) {
+	newSession(Options{Out: w}).renderChart(list, year, month, months, utils.IsTerminal(w))
+}
+
+func (s *session) renderChart(list product.ProductList, year, month, months int, color bool) {
+	summaries := make([]report.MonthSummary, 0, months)
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
+	maxValue := 0.0
+	for i := 0; i < months; i++ {
+		date := start.AddDate(0, i, 0)
+		summary := report.Summarize(list, date.Year(), int(date.Month()))
+		summaries = append(summaries, summary)
+		maxValue = max(maxValue, summary.TotalParcel, summary.SpendableValue)
+	}
+	if maxValue <= 0 {
+		maxValue = 1
+	}
+
+	last := start.AddDate(0, months-1, 0)
+	divider := strings.Repeat("-", 60)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, i18n.Sprintf(" PARCELAS DOS PRÓXIMOS MESES (%02d/%d a %02d/%d) ",
+		month, year, int(last.Month()), last.Year()))
+	fmt.Fprintln(s.out, divider)
+
+	over := 0
+	for _, summary := range summaries {
+		limitCol := scaleColumn(summary.SpendableValue, maxValue)
+		barLen := scaleColumn(summary.TotalParcel, maxValue)
+		exceeded := summary.Verdict == report.VerdictNotRecommended
+
+		var bar strings.Builder
+		for col := 0; col <= chartWidth; col++ {
+			switch {
+			case col == limitCol:
+				bar.WriteString(chartLimit)
+			case col < barLen && col > limitCol:
+				bar.WriteString(highlight(chartOverBar, color))
+			case col < barLen:
+				bar.WriteString(chartBar)
+			default:
+				bar.WriteString(" ")
+			}
+		}
+
+		label := fmt.Sprintf("%s/%02d", shortMonthName(summary.Month), summary.Year%100)
+		line := fmt.Sprintf("%s %s %12s %6s%%", label, bar.String(), money.Format(summary.TotalParcel), money.FormatPercent(summary.UsedPercent, 1))
+		if exceeded {
+			over++
+			line = highlight(label, color) + strings.TrimPrefix(line, label) + " ⚠️"
+		}
+		fmt.Fprintln(s.out, line)
+	}
+
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("%s parcelas  %s acima do limite  %s limite: %s%% do lucro (%s)\n"),
+		chartBar, chartOverBar, chartLimit, money.FormatPercent(summaries[0].SpendablePercent, 0), money.Format(summaries[0].SpendableValue))
+	if over == 0 {
+		fmt.Fprintln(s.out, i18n.T("✅ Nenhum mês acima da porcentagem segura."))
+	} else {
+		fmt.Fprintf(s.out, i18n.T("⚠️  %d mês(es) acima da porcentagem segura.\n"), over)
+	}
+}
+
+func scaleColumn(value, maxValue float64) int {
+	return int(math.Round(value / maxValue * chartWidth))
+}
+
+func shortMonthName(month int) string {
+	name := []rune(i18n.MonthName(month))
+	return string(name[:min(3, len(name))])
+}
+
+func highlight(text string, color bool) string {
+	if !color {
+		return text
+	}
+	return chartColorRed + text + chartColorReset
+}
@@ -0,0 +1,182 @@
+package menu
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/csvio"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/ledger"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+func (s *session) transferData(list *product.ProductList) {
+	title := i18n.T(" IMPORTAR/EXPORTAR ")
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("1. Importar produtos (CSV)"))
+	fmt.Fprintln(s.out, i18n.T("2. Exportar produtos (CSV)"))
+	fmt.Fprintln(s.out, i18n.T("3. Importar extrato OFX"))
+	fmt.Fprintln(s.out, i18n.T("4. Exportar diário hledger/ledger"))
+	fmt.Fprintln(s.out, i18n.T("5. Exportar calendário de vencimentos (.ics)"))
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("Cabeçalho do CSV:"), strings.Join(csvio.Header, ","))
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choice, _ := s.readLine()
+	choice = strings.TrimSpace(choice)
+
+	switch choice {
+	case "0":
+		return
+	case "1":
+		s.importCSV(list)
+	case "2":
+		s.exportCSV(list)
+	case "3":
+		s.importOFX(list)
+	case "4":
+		s.exportLedger(*list)
+	case "5":
+		s.exportCalendar(*list)
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+	}
+}
+
+func (s *session) importCSV(list *product.ProductList) {
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprint(s.out, i18n.T("Arquivo CSV a importar (0 para voltar): "))
+	path, _ := s.readLine()
+	path = strings.TrimSpace(path)
+
+	if path == "0" || path == "" {
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao abrir o arquivo:"), err)
+		s.pause(2 * time.Second)
+		return
+	}
+	defer f.Close()
+
+	now := s.clock.Now()
+	rows, err := csvio.Read(f, list.Products, now)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao ler o CSV:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, i18n.T(" PRÉVIA DA IMPORTAÇÃO "))
+	fmt.Fprintln(s.out, divider)
+
+	var valid, duplicates, invalidRows int
+	for _, row := range rows {
+		p := row.Product
+		switch {
+		case row.Err != nil:
+			invalidRows++
+			fmt.Fprintf(s.out, i18n.T("Linha %d: ❌ %s\n"), row.Line, i18n.Error(row.Err))
+		case row.Duplicate:
+			duplicates++
+			fmt.Fprintf(s.out, i18n.T("Linha %d: ⚠️  já cadastrado: %s | %s | %dx | %s\n"),
+				row.Line, p.Name, money.Format(p.TotalValue), p.Installments, i18n.FormatDate(p.CreatedAt))
+		default:
+			valid++
+			fmt.Fprintf(s.out, i18n.T("Linha %d: ✅ %s | %s | %dx | %s\n"),
+				row.Line, p.Name, money.Format(p.TotalValue), p.Installments, i18n.FormatDate(p.CreatedAt))
+		}
+	}
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("%d válido(s), %d duplicado(s), %d inválido(s).\n"), valid, duplicates, invalidRows)
+
+	if valid == 0 {
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto para importar."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintf(s.out, i18n.T("Importar %d produto(s)? (s/n): "), valid)
+	confirm, _ := s.readLine()
+	if !i18n.IsYes(confirm) {
+		fmt.Fprintln(s.out, i18n.T("Operação cancelada."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	for _, row := range rows {
+		if row.Valid() {
+			list.Add(row.Product, clock.RealNow(s.clock))
+		}
+	}
+	list.Month = int(now.Month())
+	list.Year = now.Year()
+
+	fmt.Fprintf(s.out, i18n.T("✅ %d produto(s) importado(s)!\n"), valid)
+	s.pause(2 * time.Second)
+}
+
+func (s *session) exportLedger(list product.ProductList) {
+	fmt.Fprint(s.out, i18n.T("Arquivo do diário de destino (0 para voltar): "))
+	path, _ := s.readLine()
+	path = strings.TrimSpace(path)
+
+	if path == "0" || path == "" {
+		return
+	}
+
+	f, err := os.Create(path)
+	if err == nil {
+		err = ledger.Export(f, list, ledger.DefaultOptions())
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao exportar:"), err)
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintf(s.out, i18n.T("✅ Diário exportado para %s\n"), path)
+	s.pause(2 * time.Second)
+}
+
+func (s *session) exportCSV(list *product.ProductList) {
+	fmt.Fprint(s.out, i18n.T("Arquivo CSV de destino (0 para voltar): "))
+	path, _ := s.readLine()
+	path = strings.TrimSpace(path)
+
+	if path == "0" || path == "" {
+		return
+	}
+
+	f, err := os.Create(path)
+	if err == nil {
+		err = csvio.Export(f, list.Products)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao exportar:"), err)
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintf(s.out, i18n.T("✅ %d produto(s) exportado(s) para %s\n"), len(list.Products), path)
+	s.pause(2 * time.Second)
+}
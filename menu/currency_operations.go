@@ -0,0 +1,158 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+func totalLabel(currency string, original, total float64) string {
+	if currency == "" {
+		return money.Format(total)
+	}
+	return money.FormatIn(original, currency) + " (" + money.Format(total) + ")"
+}
+
+func (s *session) readCurrency() (string, bool) {
+	fmt.Fprint(s.out, i18n.T("Moeda (Enter para BRL, ou um código como USD ou EUR): "))
+	code, _ := s.readLine()
+
+	currency, err := money.NormalizeCurrency(code)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return "", false
+	}
+	return currency, true
+}
+
+func (s *session) readExchange(currency string) (float64, float64, bool) {
+	rates, err := storage.LoadRates()
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao carregar a tabela de câmbio:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return 0, 0, false
+	}
+
+	tableRate, known := rates.Rate(currency)
+	if known {
+		fmt.Fprintf(s.out, i18n.T("Cotação %s → BRL (Enter para usar %s da tabela, 0 para voltar): "),
+			currency, money.FormatNumber(tableRate))
+	} else {
+		fmt.Fprintf(s.out, i18n.T("Cotação %s → BRL (0 para voltar): "), currency)
+	}
+	rateStr, _ := s.readLine()
+	rateStr = strings.TrimSpace(rateStr)
+
+	if rateStr == "0" {
+		return 0, 0, false
+	}
+
+	rate := tableRate
+	if rateStr != "" || !known {
+		rate, err = money.Parse(rateStr)
+		if err != nil || rate <= 0 {
+			fmt.Fprintln(s.out, i18n.T("Cotação inválida."))
+			s.pause(2 * time.Second)
+			return 0, 0, false
+		}
+	}
+
+	iof := 0.0
+	if rates.IOF > 0 {
+		fmt.Fprintf(s.out, i18n.T("Incluir IOF de %s%%? (s/n): "), money.FormatNumber(rates.IOF))
+		answer, _ := s.readLine()
+		if i18n.IsYes(answer) {
+			iof = rates.IOF
+		}
+	}
+	return rate, iof, true
+}
+
+func (s *session) manageRates() {
+	title := i18n.T(" CÂMBIO ")
+	divider := strings.Repeat("-", 40)
+
+	rates, err := storage.LoadRates()
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao carregar a tabela de câmbio:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	if len(rates.Rates) == 0 {
+		fmt.Fprintln(s.out, i18n.T("Nenhuma cotação cadastrada."))
+	}
+	for _, currency := range rates.Currencies() {
+		fmt.Fprintf(s.out, "%s: %s\n", currency, money.Format(rates.Rates[currency]))
+	}
+	fmt.Fprintf(s.out, i18n.T("IOF: %s%%\n"), money.FormatNumber(rates.IOF))
+	if !rates.UpdatedAt.IsZero() {
+		fmt.Fprintf(s.out, i18n.T("Atualizada em: %s\n"), i18n.FormatDateTime(rates.UpdatedAt))
+	}
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("1. Definir cotação"))
+	fmt.Fprintln(s.out, i18n.T("2. Remover moeda"))
+	fmt.Fprintln(s.out, i18n.T("3. Definir IOF"))
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choice, _ := s.readLine()
+	choice = strings.TrimSpace(choice)
+
+	now := clock.RealNow(s.clock)
+	switch choice {
+	case "0":
+		return
+	case "1":
+		fmt.Fprint(s.out, i18n.T("Moeda (código como USD ou EUR): "))
+		currency, _ := s.readLine()
+		rate, err := s.readFloat(i18n.T("Cotação em reais: "))
+		if err == nil {
+			err = rates.Set(currency, rate, now)
+		}
+		if err != nil {
+			fmt.Fprintln(s.out, i18n.Error(err))
+			s.pause(2 * time.Second)
+			return
+		}
+	case "2":
+		fmt.Fprint(s.out, i18n.T("Moeda a remover: "))
+		currency, _ := s.readLine()
+		if !rates.Remove(currency, now) {
+			fmt.Fprintln(s.out, i18n.Error(money.ErrUnknownRate))
+			s.pause(2 * time.Second)
+			return
+		}
+	case "3":
+		iof, err := s.readFloat(i18n.T("IOF em porcentagem (0 para não cobrar): "))
+		if err == nil {
+			err = rates.SetIOF(iof, now)
+		}
+		if err != nil {
+			fmt.Fprintln(s.out, i18n.Error(err))
+			s.pause(2 * time.Second)
+			return
+		}
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+		return
+	}
+
+	if err := storage.SaveRates(rates); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao salvar a tabela de câmbio:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+	fmt.Fprintln(s.out, i18n.T("✅ Tabela de câmbio atualizada!"))
+	s.pause(2 * time.Second)
+}
@@ -2,146 +2,89 @@ package menu
 
 import (
 	"fmt"
-	"sort"
+	"io"
 	"strings"
-	"time"
 
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
 	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
 )
 
-func showSummary(list product.ProductList) {
-	var totalParcel float64
-
-	now := time.Now()
-	targetYear := now.Year()
-	targetMonth := int(now.Month())
-
-	var activeProducts []product.Product
-
-	for _, p := range list.Products {
-		startYear, startMonth := p.CreatedAt.Year(), int(p.CreatedAt.Month())
-		endDate := p.CreatedAt.AddDate(0, p.Installments-1, 0)
-		endYear, endMonth := endDate.Year(), int(endDate.Month())
-
-		if (targetYear > startYear || (targetYear == startYear && targetMonth >= startMonth)) &&
-			(targetYear < endYear || (targetYear == endYear && targetMonth <= endMonth)) {
-			activeProducts = append(activeProducts, p)
-			totalParcel += p.Parcel
-		}
-	}
-
-	usedPercent := 0.0
-	leftPercent := 100.0
-	valorReinvestir := 0.0
+func (s *session) showSummary(list product.ProductList) {
+	now := s.clock.Now()
+	s.showMonthSummary(list, now.Year(), int(now.Month()))
+}
 
-	if list.MonthlyProfit > 0 {
-		usedPercent = (totalParcel / list.MonthlyProfit) * 100
-		leftPercent = 100 - usedPercent
-		valorReinvestir = (leftPercent / 100) * list.MonthlyProfit
-	}
+func ShowSummary(w io.Writer, summary report.MonthSummary) {
+	newSession(Options{Out: w}).printSummary(summary)
+}
 
-	spendablePercent := 100.0 - list.SafePercentage
-	spendableValue := (spendablePercent / 100) * list.MonthlyProfit
-	remainingSpendableValue := spendableValue - totalParcel
-	if remainingSpendableValue < 0 {
-		remainingSpendableValue = 0
-	}
+func (s *session) showMonthSummary(list product.ProductList, targetYear, targetMonth int) {
+	s.printSummary(report.Summarize(list, targetYear, targetMonth))
+}
 
-	monthName := monthNames[targetMonth-1]
+func (s *session) printSummary(summary report.MonthSummary) {
+	targetYear, targetMonth := summary.Year, summary.Month
+	monthName := i18n.MonthName(targetMonth)
 
 	summaryDivider := strings.Repeat("-", 60)
-	title := fmt.Sprintf(" RESUMO DO MÊS (%02d/%d - %s) ", targetMonth, targetYear, monthName)
+	title := i18n.Sprintf(" RESUMO DO MÊS (%02d/%d - %s) ", targetMonth, targetYear, monthName)
 
-	fmt.Println("\n" + summaryDivider)
-	fmt.Println(title)
-	fmt.Println(summaryDivider)
+	fmt.Fprintln(s.out, "\n"+summaryDivider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, summaryDivider)
 
-	fmt.Printf("Lucro mensal: R$%.2f\n", list.MonthlyProfit)
-	fmt.Printf("Total de parcelas: R$%.2f\n", totalParcel)
-	fmt.Printf("Usado: %.2f%% | Para reinvestir: %.2f%% (R$%.2f)\n", usedPercent, leftPercent, valorReinvestir)
-	fmt.Printf("Porcentagem segura configurada: %.0f%%\n", list.SafePercentage)
-	fmt.Printf("Disponível para gastos: %.0f%% (R$%.2f) | Restante: R$%.2f\n",
-		spendablePercent, spendableValue, remainingSpendableValue)
-
-	fmt.Println("")
-	fmt.Println(summaryDivider)
-
-	if leftPercent >= list.SafePercentage {
-		fmt.Println("✅ Você pode usar parte do seu lucro para pagar as parcelas!")
+	if summary.Person != "" {
+		fmt.Fprintf(s.out, i18n.T("Pessoa: %s\n"), personLabel(summary.Person))
+	}
+	fmt.Fprintf(s.out, i18n.T("Lucro mensal: %s\n"), money.Format(summary.MonthlyProfit))
+	fmt.Fprintf(s.out, i18n.T("Total de parcelas: %s\n"), money.Format(summary.TotalParcel))
+	fmt.Fprintf(s.out, i18n.T("Usado: %s%% | Para reinvestir: %s%% (%s)\n"),
+		money.FormatPercent(summary.UsedPercent, 2), money.FormatPercent(summary.LeftPercent, 2), money.Format(summary.ReinvestValue))
+	fmt.Fprintf(s.out, i18n.T("Porcentagem segura configurada: %s%%\n"), money.FormatPercent(summary.SafePercentage, 0))
+	fmt.Fprintf(s.out, i18n.T("Disponível para gastos: %s%% (%s) | Restante: %s\n"),
+		money.FormatPercent(summary.SpendablePercent, 0), money.Format(summary.SpendableValue), money.Format(summary.RemainingSpendable))
+
+	fmt.Fprintln(s.out, "")
+	fmt.Fprintln(s.out, summaryDivider)
+
+	if summary.Verdict == report.VerdictOK {
+		fmt.Fprintln(s.out, i18n.T("✅ Você pode usar parte do seu lucro para pagar as parcelas!"))
 	} else {
-		fmt.Println("❌ Não recomendado. Crie uma caixinha separada para alguns produtos!")
-		suggestProductsToSeparate(activeProducts, list.MonthlyProfit, list.SafePercentage)
+		fmt.Fprintln(s.out, i18n.T("❌ Não recomendado. Crie uma caixinha separada para alguns produtos!"))
+		s.showSuggestedProducts(summary.Suggested, summary.SuggestedTotal)
 	}
 
-	if len(activeProducts) > 0 {
-		productsTitle := " PRODUTOS ATIVOS NESTE MÊS "
-		fmt.Println("\n" + summaryDivider)
-		fmt.Println(productsTitle)
-		fmt.Println(summaryDivider)
+	if len(summary.Products) > 0 {
+		productsTitle := i18n.T(" PRODUTOS ATIVOS NESTE MÊS ")
+		fmt.Fprintln(s.out, "\n"+summaryDivider)
+		fmt.Fprintln(s.out, productsTitle)
+		fmt.Fprintln(s.out, summaryDivider)
 
-		for i, p := range activeProducts {
-			installmentNumber := getInstallmentNumber(p, targetYear, targetMonth)
-			fmt.Printf("%d. %s | Total: R$%.2f | Parcela: R$%.2f (%d/%d)\n",
-				i+1, p.Name, p.TotalValue, p.Parcel, installmentNumber, p.Installments)
+		for i, p := range summary.Products {
+			fmt.Fprintf(s.out, i18n.T("%d. %s | Total: %s | Parcela: %s (%d/%d)%s%s\n"),
+				i+1, p.Name, totalLabel(p.Currency, p.OriginalValue, p.TotalValue), money.Format(p.Parcel), p.InstallmentNumber, p.Installments, paidMark(p.Paid), shareLabel(p))
 		}
-		fmt.Println(summaryDivider)
+		fmt.Fprintln(s.out, summaryDivider)
 	}
 }
 
-func suggestProductsToSeparate(products []product.Product, monthlyProfit float64, safePercentage float64) {
-	if len(products) == 0 {
-		return
-	}
-
-	type ProductWithIndex struct {
-		Index   int
-		Product product.Product
-	}
-
-	productsWithIndex := make([]ProductWithIndex, len(products))
-	for i, p := range products {
-		productsWithIndex[i] = ProductWithIndex{i, p}
-	}
-
-	sort.Slice(productsWithIndex, func(i, j int) bool {
-		return productsWithIndex[i].Product.Parcel > productsWithIndex[j].Product.Parcel
-	})
-
-	var totalParcel float64
-	for _, p := range products {
-		totalParcel += p.Parcel
-	}
-
-	targetParcel := totalParcel - (monthlyProfit * (safePercentage / 100))
-	if targetParcel <= 0 {
-		return
-	}
-
-	var suggestedProducts []product.Product
-	var suggestedParcelSum float64
-
-	for _, pwi := range productsWithIndex {
-		if suggestedParcelSum >= targetParcel {
-			break
-		}
-		suggestedProducts = append(suggestedProducts, pwi.Product)
-		suggestedParcelSum += pwi.Product.Parcel
-	}
-
+func (s *session) showSuggestedProducts(suggestedProducts []report.ProductLine, suggestedParcelSum float64) {
 	suggestionDivider := strings.Repeat("-", 50)
 
 	if len(suggestedProducts) == 1 {
-		fmt.Println(suggestionDivider)
-		fmt.Printf("💡 Sugestão: Separe o produto '%s' (Parcela: R$%.2f) em uma caixinha separada.\n",
-			suggestedProducts[0].Name, suggestedProducts[0].Parcel)
-		fmt.Println(suggestionDivider)
+		fmt.Fprintln(s.out, suggestionDivider)
+		fmt.Fprintf(s.out, i18n.T("💡 Sugestão: Separe o produto '%s' (Parcela: %s) em uma caixinha separada.\n"),
+			suggestedProducts[0].Name, money.Format(ownParcel(suggestedProducts[0])))
+		fmt.Fprintln(s.out, suggestionDivider)
 	} else if len(suggestedProducts) > 1 {
-		fmt.Println(suggestionDivider)
-		fmt.Println("💡 Sugestão: Separe os seguintes produtos em uma caixinha:")
+		fmt.Fprintln(s.out, suggestionDivider)
+		fmt.Fprintln(s.out, i18n.T("💡 Sugestão: Separe os seguintes produtos em uma caixinha:"))
 		for i, p := range suggestedProducts {
-			fmt.Printf("  %d. %s (Parcela: R$%.2f)\n", i+1, p.Name, p.Parcel)
+			fmt.Fprintf(s.out, i18n.T("  %d. %s (Parcela: %s)\n"), i+1, p.Name, money.Format(ownParcel(p)))
 		}
-		fmt.Printf("  Total a separar: R$%.2f\n", suggestedParcelSum)
-		fmt.Println(suggestionDivider)
+		fmt.Fprintf(s.out, i18n.T("  Total a separar: %s\n"), money.Format(suggestedParcelSum))
+		fmt.Fprintln(s.out, suggestionDivider)
 	}
 }
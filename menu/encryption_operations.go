@@ -0,0 +1,172 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+const maxPassphraseAttempts = 3
+
+func (s *session) unlockProfile(profile string) bool {
+	encrypted, err := storage.IsEncrypted(profile)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao ler os dados:"), i18n.Error(err))
+		return false
+	}
+	if !encrypted || storage.IsUnlocked(profile) {
+		return true
+	}
+
+	for attempt := 1; attempt <= maxPassphraseAttempts; attempt++ {
+		fmt.Fprintf(s.out, i18n.T("Senha do perfil '%s' (0 para voltar): "), profile)
+		passphrase := s.readPassword()
+
+		if passphrase == "0" {
+			return false
+		}
+
+		err := storage.Unlock(profile, passphrase)
+		if err == nil {
+			return true
+		}
+		fmt.Fprintln(s.out, "❌", i18n.Error(err))
+	}
+
+	fmt.Fprintln(s.out, i18n.T("Número máximo de tentativas atingido."))
+	s.pause(2 * time.Second)
+	return false
+}
+
+func (s *session) manageEncryption(profile string, list *product.ProductList) {
+	title := i18n.T(" CRIPTOGRAFIA DOS DADOS ")
+	divider := strings.Repeat("-", 40)
+
+	encrypted, err := storage.IsEncrypted(profile)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao ler os dados:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	if encrypted {
+		fmt.Fprintf(s.out, i18n.T("Perfil '%s': 🔒 criptografado\n"), profile)
+		fmt.Fprintln(s.out, divider)
+		fmt.Fprintln(s.out, i18n.T("1. Trocar senha"))
+		fmt.Fprintln(s.out, i18n.T("2. Desativar criptografia"))
+	} else {
+		fmt.Fprintf(s.out, i18n.T("Perfil '%s': 🔓 sem criptografia\n"), profile)
+		fmt.Fprintln(s.out, divider)
+		fmt.Fprintln(s.out, i18n.T("1. Ativar criptografia"))
+	}
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choice, _ := s.readLine()
+	choice = strings.TrimSpace(choice)
+
+	switch {
+	case choice == "0":
+		return
+	case choice == "1" && !encrypted:
+		s.enableEncryption(profile, list)
+	case choice == "1" && encrypted:
+		s.changePassphrase(profile, list)
+	case choice == "2" && encrypted:
+		s.disableEncryption(profile, list)
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+	}
+}
+
+func (s *session) enableEncryption(profile string, list *product.ProductList) {
+	fmt.Fprintln(s.out, i18n.T("\n⚠️  Guarde a senha em local seguro: sem ela não é possível recuperar os dados."))
+	passphrase, ok := s.readNewPassphrase()
+	if !ok {
+		return
+	}
+
+	if err := storage.EnableEncryption(profile, list, passphrase); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao ativar a criptografia:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, i18n.T("✅ Criptografia ativada!"))
+	s.pause(2 * time.Second)
+}
+
+func (s *session) changePassphrase(profile string, list *product.ProductList) {
+	fmt.Fprint(s.out, i18n.T("\nSenha atual: "))
+	current := s.readPassword()
+	if err := storage.Unlock(profile, current); err != nil {
+		fmt.Fprintln(s.out, "❌", i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	passphrase, ok := s.readNewPassphrase()
+	if !ok {
+		return
+	}
+
+	if err := storage.ChangePassphrase(profile, list, passphrase); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao trocar a senha:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, i18n.T("✅ Senha alterada!"))
+	s.pause(2 * time.Second)
+}
+
+func (s *session) disableEncryption(profile string, list *product.ProductList) {
+	fmt.Fprint(s.out, i18n.T("\nOs dados voltarão a ser salvos em texto puro. Confirmar? (s/n): "))
+	confirm, _ := s.readLine()
+	confirm = strings.TrimSpace(strings.ToLower(confirm))
+	if !i18n.IsYes(confirm) {
+		fmt.Fprintln(s.out, i18n.T("Operação cancelada."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	if err := storage.DisableEncryption(profile, list); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao desativar a criptografia:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, i18n.T("✅ Criptografia desativada."))
+	s.pause(2 * time.Second)
+}
+
+func (s *session) readNewPassphrase() (string, bool) {
+	fmt.Fprint(s.out, i18n.T("Nova senha (0 para voltar): "))
+	passphrase := s.readPassword()
+
+	if passphrase == "0" {
+		return "", false
+	}
+
+	if passphrase == "" {
+		fmt.Fprintln(s.out, i18n.T("A senha não pode ser vazia."))
+		s.pause(2 * time.Second)
+		return "", false
+	}
+
+	fmt.Fprint(s.out, i18n.T("Confirme a nova senha: "))
+	if s.readPassword() != passphrase {
+		fmt.Fprintln(s.out, i18n.T("As senhas não conferem."))
+		s.pause(2 * time.Second)
+		return "", false
+	}
+	return passphrase, true
+}
@@ -0,0 +1,229 @@
+package menu
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+func (s *session) undoLastChange(list *product.ProductList) {
+	divider := strings.Repeat("-", 40)
+
+	e, err := list.Undo(clock.RealNow(s.clock))
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("↩️  Desfeito: %s\n"), describeEvent(e))
+	fmt.Fprintln(s.out, divider)
+	s.pause(2 * time.Second)
+}
+
+func (s *session) redoLastChange(list *product.ProductList) {
+	divider := strings.Repeat("-", 40)
+
+	e, err := list.Redo(clock.RealNow(s.clock))
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("↪️  Refeito: %s\n"), describeEvent(e))
+	fmt.Fprintln(s.out, divider)
+	s.pause(2 * time.Second)
+}
+
+func undoRedoLabel(label string, e product.Event, ok bool) string {
+	if !ok {
+		return label
+	}
+	return fmt.Sprintf("%s (%s)", label, describeEvent(e))
+}
+
+func describeEvent(e product.Event) string {
+	switch e.Type {
+	case product.EventAdd:
+		return i18n.Sprintf("adicionar '%s'", e.After.Name)
+	case product.EventRemove:
+		return i18n.Sprintf("remover '%s'", e.Before.Name)
+	case product.EventEdit:
+		return i18n.Sprintf("editar '%s'", e.Before.Name)
+	case product.EventAnticipate:
+		return i18n.Sprintf("antecipar %d parcela(s) de '%s'", e.Before.Installments-e.After.Installments, e.Before.Name)
+	case product.EventPay:
+		return i18n.Sprintf("marcar parcela %d de '%s' como paga", paidInstallment(e.Before, e.After), e.Before.Name)
+	case product.EventMonthlyProfit:
+		return i18n.Sprintf("lucro mensal %s → %s", money.Format(e.OldValue), money.Format(e.NewValue))
+	case product.EventSafePercentage:
+		return i18n.Sprintf("porcentagem segura %s%% → %s%%", money.FormatPercent(e.OldValue, 0), money.FormatPercent(e.NewValue, 0))
+	case product.EventPerson:
+		return describePersonChange(e.PersonBefore, e.PersonAfter)
+	case product.EventOwner:
+		return i18n.Sprintf("titular '%s' → '%s'", personLabel(e.OldName), personLabel(e.NewName))
+	case product.EventUndo:
+		return i18n.T("desfazer")
+	case product.EventRedo:
+		return i18n.T("refazer")
+	default:
+		return string(e.Type)
+	}
+}
+
+func describePersonChange(before, after *product.Person) string {
+	switch {
+	case before == nil:
+		return i18n.Sprintf("adicionar pessoa '%s'", after.Name)
+	case after == nil:
+		return i18n.Sprintf("remover pessoa '%s'", before.Name)
+	default:
+		return i18n.Sprintf("renda de '%s' %s → %s", before.Name, money.Format(before.MonthlyProfit), money.Format(after.MonthlyProfit))
+	}
+}
+
+func (s *session) showProductHistory(list product.ProductList) {
+	title := i18n.T(" HISTÓRICO DE PRODUTO ")
+	divider := strings.Repeat("-", 60)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+
+	products := list.HistoryProducts()
+	if len(products) == 0 {
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto cadastrado."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	for _, p := range products {
+		name := p.Name
+		if _, ok := list.FindByID(p.ID); !ok {
+			name = i18n.Sprintf("%s (removido)", p.Name)
+		}
+		fmt.Fprintf(s.out, i18n.T("%d. %s | Total: %s | Parcelas: %d | Adicionado em: %s\n"),
+			p.ID, name, totalLabel(p.Currency, p.OriginalValue, p.TotalValue), p.Installments, i18n.FormatDate(p.CreatedAt))
+	}
+	fmt.Fprint(s.out, i18n.T("ID do produto (0 para voltar): "))
+	idStr, _ := s.readLine()
+	idStr = strings.TrimSpace(idStr)
+	if idStr == "0" {
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	idx := slices.IndexFunc(products, func(p product.Product) bool { return p.ID == id })
+	if err != nil || idx < 0 {
+		fmt.Fprintln(s.out, i18n.T("Produto inválido."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	p := products[idx]
+	history := list.ProductHistory(p.ID)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintf(s.out, i18n.T(" HISTÓRICO DE '%s' \n"), p.Name)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("Cadastrado em: %s\n"), i18n.FormatDateTime(p.CreatedAt))
+
+	if len(history) == 0 {
+		fmt.Fprintln(s.out, i18n.T("Nenhuma alteração registrada para este produto."))
+	}
+
+	for _, e := range history {
+		fmt.Fprintf(s.out, "%s | %s\n", i18n.FormatDateTime(e.Time.Local()), describeHistoryEntry(list, e))
+	}
+	fmt.Fprintln(s.out, divider)
+
+	fmt.Fprint(s.out, i18n.T("\nPressione Enter para voltar..."))
+	s.readLine()
+}
+
+func describeHistoryEntry(list product.ProductList, e product.Event) string {
+	switch e.Type {
+	case product.EventUndo:
+		target, _ := list.EventBySeq(e.Target)
+		return i18n.T("Desfeito: ") + describeProductChange(list, invertedEventType(target.Type), target.After, target.Before)
+	case product.EventRedo:
+		target, _ := list.EventBySeq(e.Target)
+		return i18n.T("Refeito: ") + describeProductChange(list, target.Type, target.Before, target.After)
+	default:
+		return describeProductChange(list, e.Type, e.Before, e.After)
+	}
+}
+
+func describeProductChange(list product.ProductList, eventType product.EventType, before, after *product.Product) string {
+	switch eventType {
+	case product.EventAdd:
+		return i18n.Sprintf("Adicionado | Nome: %s | Total: %s | Parcelas: %d",
+			after.Name, money.Format(after.TotalValue), after.Installments)
+	case product.EventRemove:
+		return i18n.Sprintf("Removido | Nome: %s | Total: %s | Parcelas: %d",
+			before.Name, money.Format(before.TotalValue), before.Installments)
+	case product.EventAnticipate:
+		return i18n.Sprintf("Antecipação | Parcelas: %d → %d", before.Installments, after.Installments)
+	case product.EventPay:
+		if number := paidInstallment(before, after); number > 0 {
+			return i18n.Sprintf("Parcela %d/%d paga", number, after.Installments)
+		}
+		return i18n.Sprintf("Parcela %d/%d não paga", paidInstallment(after, before), after.Installments)
+	}
+
+	var changes []string
+	if before.Name != after.Name {
+		changes = append(changes, i18n.Sprintf("Nome: %s → %s", before.Name, after.Name))
+	}
+	if before.Category != after.Category {
+		changes = append(changes, i18n.Sprintf("Categoria: %s → %s", categoryLabel(before.Category), categoryLabel(after.Category)))
+	}
+	if before.Card != after.Card {
+		changes = append(changes, i18n.Sprintf("Cartão: %s → %s", cardLabel(before.Card), cardLabel(after.Card)))
+	}
+	if before.TotalValue != after.TotalValue {
+		changes = append(changes, i18n.Sprintf("Total: %s → %s", money.Format(before.TotalValue), money.Format(after.TotalValue)))
+	}
+	if before.Installments != after.Installments {
+		changes = append(changes, i18n.Sprintf("Parcelas: %d → %d", before.Installments, after.Installments))
+	}
+	if from, to := splitLabel(list, *before), splitLabel(list, *after); from != to {
+		changes = append(changes, i18n.Sprintf("Divisão: %s → %s", from, to))
+	}
+	if len(changes) == 0 {
+		return i18n.T("Editado | Sem alterações")
+	}
+	return i18n.T("Editado | ") + strings.Join(changes, " | ")
+}
+
+func paidInstallment(before, after *product.Product) int {
+	for _, number := range after.Paid {
+		if !before.IsPaid(number) {
+			return number
+		}
+	}
+	return 0
+}
+
+func invertedEventType(eventType product.EventType) product.EventType {
+	switch eventType {
+	case product.EventAdd:
+		return product.EventRemove
+	case product.EventRemove:
+		return product.EventAdd
+	default:
+		return eventType
+	}
+}
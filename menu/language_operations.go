@@ -0,0 +1,64 @@
+package menu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+var languageNames = map[string]string{
+	i18n.PtBR: "Português (Brasil)",
+	i18n.EnUS: "English (US)",
+	i18n.Es:   "Español",
+}
+
+func (s *session) chooseLanguage() {
+	title := i18n.T(" IDIOMA ")
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+
+	locales := i18n.Locales()
+	for i, locale := range locales {
+		marker := ""
+		if locale == i18n.Locale() {
+			marker = i18n.T(" (atual)")
+		}
+		fmt.Fprintf(s.out, "%d. %s%s\n", i+1, languageNames[locale], marker)
+	}
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choiceStr, _ := s.readLine()
+	choiceStr = strings.TrimSpace(choiceStr)
+
+	if choiceStr == "0" {
+		return
+	}
+
+	choice, err := strconv.Atoi(choiceStr)
+	if err != nil || choice < 1 || choice > len(locales) {
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+		return
+	}
+
+	locale := locales[choice-1]
+	config, _ := storage.LoadConfig()
+	config.Language = locale
+	if err := storage.SaveConfig(config); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao salvar configuração:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	i18n.SetLocale(locale)
+	fmt.Fprintf(s.out, i18n.T("✅ Idioma: %s\n"), languageNames[locale])
+	s.pause(2 * time.Second)
+}
@@ -1,92 +1,253 @@
 package menu
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
 	"github.com/pedrorcruzz/smart-spending-checker/storage"
-	"github.com/pedrorcruzz/smart-spending-checker/utils"
 )
 
-var monthNames = []string{
-	"Janeiro", "Fevereiro", "Março", "Abril", "Maio", "Junho",
-	"Julho", "Agosto", "Setembro", "Outubro", "Novembro", "Dezembro",
-}
-
-func ShowMenu() {
-	reader := bufio.NewReader(os.Stdin)
-	list, _ := storage.LoadProducts()
-
-	if list.SafePercentage == 0 {
-		list.SafePercentage = 70
+func ShowMenu(opts Options) {
+	s := newSession(opts)
+	profile := opts.Profile
+	if profile == "" {
+		config, _ := storage.LoadConfig()
+		profile = config.ActiveProfile
+	}
+	list, ok := s.loadProducts(profile)
+	if !ok {
+		fmt.Fprintln(s.out, i18n.T("Saindo..."))
+		return
 	}
 
 	for {
-		utils.ClearTerminal()
-		title := " Gestor Inteligente de Gastos "
+		if s.eof {
+			s.saveProducts(profile, &list)
+			return
+		}
+
+		if revision, err := storage.CurrentRevision(profile); err == nil && revision != list.Revision {
+			if list, ok = s.loadProducts(profile); !ok {
+				fmt.Fprintln(s.out, i18n.T("Saindo..."))
+				return
+			}
+		}
+
+		s.clear()
+		title := i18n.T(" Gestor Inteligente de Gastos ")
 		divider := strings.Repeat("=", len(title)+10)
 
-		fmt.Println("\n" + divider)
-		fmt.Println(strings.Repeat(" ", 5) + title)
-		fmt.Println(divider)
+		fmt.Fprintln(s.out, "\n"+divider)
+		fmt.Fprintln(s.out, strings.Repeat(" ", 5)+title)
+		fmt.Fprintln(s.out, divider)
+		fmt.Fprintf(s.out, i18n.T("Perfil: %s\n"), profile)
+		if clock.IsShifted(s.clock) {
+			now := s.clock.Now()
+			fmt.Fprintf(s.out, i18n.T("🕒 Visualizando como em %02d/%d\n"), int(now.Month()), now.Year())
+		}
 
 		if list.MonthlyProfit == 0 {
-			fmt.Println("\nPor favor, defina seu lucro mensal antes de adicionar produtos.")
-			updateMonthlyProfit(reader, &list)
+			fmt.Fprintln(s.out, i18n.T("\nPor favor, defina seu lucro mensal antes de adicionar produtos."))
+			s.updateMonthlyProfit(&list)
 			continue
 		}
-		showSummary(list)
+		s.showAlerts(profile, list)
+		s.showSummary(list)
 
 		menuDivider := strings.Repeat("-", 40)
-		fmt.Println("\n" + menuDivider)
-		fmt.Println(" MENU PRINCIPAL")
-		fmt.Println(menuDivider)
-		fmt.Println("1. Adicionar produto")
-		fmt.Println("2. Remover produto")
-		fmt.Println("3. Listar meses")
-		fmt.Println("4. Atualizar lucro mensal")
-		fmt.Println("5. Editar produto")
-		fmt.Println("6. Antecipar parcelas")
-		fmt.Println("7. Configurar porcentagem segura")
-		fmt.Println("8. Sair")
-		fmt.Println(menuDivider)
-		fmt.Print("Escolha uma opcão: ")
-		choice, _ := reader.ReadString('\n')
+		fmt.Fprintln(s.out, "\n"+menuDivider)
+		fmt.Fprintln(s.out, i18n.T(" MENU PRINCIPAL"))
+		fmt.Fprintln(s.out, menuDivider)
+		fmt.Fprintln(s.out, i18n.T("1. Adicionar produto"))
+		fmt.Fprintln(s.out, i18n.T("2. Remover produto"))
+		fmt.Fprintln(s.out, i18n.T("3. Listar meses"))
+		fmt.Fprintln(s.out, i18n.T("4. Atualizar lucro mensal"))
+		fmt.Fprintln(s.out, i18n.T("5. Editar produto"))
+		fmt.Fprintln(s.out, i18n.T("6. Antecipar parcelas"))
+		fmt.Fprintln(s.out, i18n.T("7. Configurar porcentagem segura"))
+		fmt.Fprintln(s.out, i18n.T("8. Perfis"))
+		fmt.Fprintln(s.out, i18n.T("9. Criptografia dos dados"))
+		fmt.Fprintln(s.out, i18n.T("10. Histórico de produto"))
+		fmt.Fprintln(s.out, i18n.T("11. Buscar produto"))
+		fmt.Fprintln(s.out, i18n.T("12. Idioma"))
+		fmt.Fprintln(s.out, i18n.T("13. Câmbio"))
+		fmt.Fprintln(s.out, i18n.T("14. Importar/exportar"))
+		fmt.Fprintln(s.out, i18n.T("15. Gráfico dos próximos meses"))
+		fmt.Fprintln(s.out, i18n.T("16. Alertas"))
+		fmt.Fprintln(s.out, i18n.T("17. Divisão de compras"))
+		nextUndo, canUndo := list.NextUndo()
+		nextRedo, canRedo := list.NextRedo()
+		fmt.Fprintln(s.out, "18. "+undoRedoLabel(i18n.T("Desfazer"), nextUndo, canUndo))
+		fmt.Fprintln(s.out, "19. "+undoRedoLabel(i18n.T("Refazer"), nextRedo, canRedo))
+		fmt.Fprintln(s.out, i18n.T("0. Sair"))
+		fmt.Fprintln(s.out, menuDivider)
+		fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+		choice, _ := s.readLine()
 		choice = strings.TrimSpace(choice)
+		if s.eof && choice == "" {
+			continue
+		}
 
 		switch choice {
 		case "1":
-			utils.ClearTerminal()
-			addProduct(reader, &list)
+			s.clear()
+			s.addProduct(&list)
 		case "2":
-			utils.ClearTerminal()
-			removeProduct(reader, &list)
+			s.clear()
+			s.removeProduct(&list)
 		case "3":
-			utils.ClearTerminal()
-			listMonths(reader, list)
+			s.clear()
+			s.listMonths(list)
 		case "4":
-			utils.ClearTerminal()
-			updateMonthlyProfit(reader, &list)
+			s.clear()
+			s.updateMonthlyProfit(&list)
 		case "5":
-			utils.ClearTerminal()
-			editProduct(reader, &list)
+			s.clear()
+			s.editProduct(&list)
 		case "6":
-			utils.ClearTerminal()
-			anticipateInstallments(reader, &list)
+			s.clear()
+			s.anticipateInstallments(&list)
 		case "7":
-			utils.ClearTerminal()
-			configureSafePercentage(reader, &list)
+			s.clear()
+			s.configureSafePercentage(&list)
 		case "8":
-			storage.SaveProducts(list)
-			fmt.Println("Saindo...")
+			s.clear()
+			s.saveProducts(profile, &list)
+			previous := profile
+			if s.manageProfiles(&profile) {
+				if newList, ok := s.loadProducts(profile); ok {
+					list = newList
+				} else {
+					s.activateProfile(previous, &profile)
+				}
+				continue
+			}
+		case "9":
+			s.clear()
+			s.saveProducts(profile, &list)
+			s.manageEncryption(profile, &list)
+		case "10":
+			s.clear()
+			s.showProductHistory(list)
+		case "11":
+			s.clear()
+			s.searchProducts(&list)
+		case "12":
+			s.clear()
+			s.chooseLanguage()
+		case "13":
+			s.clear()
+			s.manageRates()
+		case "14":
+			s.clear()
+			s.transferData(&list)
+		case "15":
+			s.clear()
+			s.showChart(list)
+		case "16":
+			s.clear()
+			s.manageAlerts(profile)
+		case "17":
+			s.clear()
+			s.manageSplits(&list)
+		case "18":
+			s.undoLastChange(&list)
+		case "19":
+			s.redoLastChange(&list)
+		case "0":
+			s.saveProducts(profile, &list)
+			fmt.Fprintln(s.out, i18n.T("Saindo..."))
 			return
 		default:
-			fmt.Println("Opcão inválida.")
-			time.Sleep(1 * time.Second)
+			fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+			s.pause(1 * time.Second)
+		}
+		s.saveProducts(profile, &list)
+	}
+}
+
+func (s *session) loadProducts(profile string) (product.ProductList, bool) {
+	list, err := storage.LoadProducts(profile)
+	if errors.Is(err, storage.ErrWrongPassphrase) {
+		storage.Lock(profile)
+	}
+	if errors.Is(err, storage.ErrPassphraseRequired) || errors.Is(err, storage.ErrWrongPassphrase) {
+		if !s.unlockProfile(profile) {
+			return list, false
+		}
+		list, err = storage.LoadProducts(profile)
+	}
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao carregar os dados:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return list, false
+	}
+
+	if list.SafePercentage == 0 {
+		list.SafePercentage = 70
+	}
+	return list, true
+}
+
+func (s *session) saveProducts(profile string, list *product.ProductList) {
+	err := storage.SaveProducts(profile, list)
+	if err == nil {
+		return
+	}
+
+	if !errors.Is(err, storage.ErrConflict) {
+		fmt.Fprintln(s.out, i18n.T("Erro ao salvar os dados:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	pending := list.PendingEvents()
+	if len(pending) == 0 {
+		if reloaded, ok := s.loadProducts(profile); ok {
+			*list = reloaded
+		}
+		return
+	}
+
+	fmt.Fprintln(s.out, i18n.T("\n⚠️  Os dados deste perfil foram modificados em outra sessão."))
+	merge := true
+	for {
+		fmt.Fprint(s.out, i18n.T("Recarregar e descartar suas alterações (r) ou mesclá-las com os dados atuais (m)? "))
+		choice, _ := s.readLine()
+		if i18n.IsReload(choice) {
+			merge = false
+			break
 		}
-		storage.SaveProducts(list)
+		if i18n.IsMerge(choice) || s.eof {
+			break
+		}
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+	}
+
+	reloaded, ok := s.loadProducts(profile)
+	if !ok {
+		return
+	}
+	*list = reloaded
+
+	if !merge {
+		fmt.Fprintln(s.out, i18n.T("✅ Dados recarregados."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	if skipped := list.Merge(pending); skipped > 0 {
+		fmt.Fprintf(s.out, i18n.T("⚠️  %d alteração(ões) não puderam ser aplicadas e foram descartadas.\n"), skipped)
+	}
+	if err := storage.SaveProducts(profile, list); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao salvar os dados:"), i18n.Error(err))
+	} else {
+		fmt.Fprintln(s.out, i18n.T("✅ Alterações mescladas."))
 	}
+	s.pause(2 * time.Second)
 }
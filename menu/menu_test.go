@@ -0,0 +1,110 @@
+package menu
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+var update = flag.Bool("update", false, "regrava os arquivos golden")
+
+func TestScriptedSession(t *testing.T) {
+	if err := i18n.SetLocale("pt-BR"); err != nil {
+		t.Fatal(err)
+	}
+
+	script := strings.Join([]string{
+		"5000",
+		"1", "Notebook", "", "3.000,00", "10", "Eletrônicos", "Nubank",
+		"1", "Fone", "", "300", "3", "", "",
+		"18",
+		"19",
+		"18",
+		"10", "2", "",
+		"0",
+	}, "\n") + "\n"
+
+	var out bytes.Buffer
+	ShowMenu(Options{
+		In:      strings.NewReader(script),
+		Out:     &out,
+		Pause:   NoPause,
+		Clock:   clock.Fixed{Time: time.Date(2026, time.March, 15, 12, 0, 0, 0, time.Local)},
+		DataDir: t.TempDir(),
+	})
+
+	golden := filepath.Join("testdata", "session.golden")
+	if *update {
+		if err := os.WriteFile(golden, out.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out.String(); got != string(want) {
+		t.Errorf("saída diferente de %s (rode go test ./menu -update para regravar):\n%s", golden, got)
+	}
+
+	config, err := storage.LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := storage.LoadProducts(config.ActiveProfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Products) != 1 || list.Products[0].Name != "Notebook" || list.MonthlyProfit != 5000 {
+		t.Errorf("dados salvos inesperados: %+v", list)
+	}
+}
+
+func TestSessionWithProfile(t *testing.T) {
+	dir := t.TempDir()
+	storage.SetDataDir(dir)
+	if err := storage.CreateProfile("empresa"); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	ShowMenu(Options{
+		In:      strings.NewReader("8000\n0\n"),
+		Out:     &out,
+		Pause:   NoPause,
+		Clock:   clock.Fixed{Time: time.Date(2026, time.March, 15, 12, 0, 0, 0, time.Local)},
+		DataDir: dir,
+		Profile: "empresa",
+	})
+
+	if !strings.Contains(out.String(), "Perfil: empresa") {
+		t.Errorf("o menu deveria abrir no perfil empresa:\n%s", out.String())
+	}
+	list, err := storage.LoadProducts("empresa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.MonthlyProfit != 8000 {
+		t.Errorf("lucro do perfil empresa = %.2f, esperado 8000", list.MonthlyProfit)
+	}
+
+	config, err := storage.LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.ActiveProfile != storage.DefaultProfile {
+		t.Errorf("perfil ativo = %s, esperado %s", config.ActiveProfile, storage.DefaultProfile)
+	}
+	if main, err := storage.LoadProducts(storage.DefaultProfile); err != nil || main.MonthlyProfit != 0 {
+		t.Errorf("o perfil principal não deveria mudar: lucro %.2f, %v", main.MonthlyProfit, err)
+	}
+}
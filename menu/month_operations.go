@@ -1,30 +1,32 @@
 package menu
 
 import (
-	"bufio"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
 	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
 )
 
-func listMonths(reader *bufio.Reader, list product.ProductList) {
-	title := " LISTAR MESES "
+func (s *session) listMonths(list product.ProductList) {
+	title := i18n.T(" LISTAR MESES ")
 	divider := strings.Repeat("-", 40)
 
-	fmt.Println("\n" + divider)
-	fmt.Println(title)
-	fmt.Println(divider)
-	fmt.Println("0. Voltar ao Menu")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
 
 	byYearMonth := mapProductsByYearMonth(list.Products)
 	if len(byYearMonth) == 0 {
-		fmt.Println("Nenhum produto cadastrado.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto cadastrado."))
+		s.pause(2 * time.Second)
 		return
 	}
 
@@ -34,12 +36,12 @@ func listMonths(reader *bufio.Reader, list product.ProductList) {
 	}
 	sort.Ints(years)
 
-	fmt.Println("\nSelecione o ano (0 para voltar):")
+	fmt.Fprintln(s.out, i18n.T("\nSelecione o ano (0 para voltar):"))
 	for i, y := range years {
-		fmt.Printf("%d. %d\n", i+1, y)
+		fmt.Fprintf(s.out, "%d. %d\n", i+1, y)
 	}
-	fmt.Print("Ano: ")
-	yearStr, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Ano: "))
+	yearStr, _ := s.readLine()
 	yearStr = strings.TrimSpace(yearStr)
 
 	if yearStr == "0" {
@@ -48,8 +50,8 @@ func listMonths(reader *bufio.Reader, list product.ProductList) {
 
 	yearIdx, err := strconv.Atoi(yearStr)
 	if err != nil || yearIdx < 1 || yearIdx > len(years) {
-		fmt.Println("Ano inválido.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Ano inválido."))
+		s.pause(2 * time.Second)
 		return
 	}
 	year := years[yearIdx-1]
@@ -61,12 +63,12 @@ func listMonths(reader *bufio.Reader, list product.ProductList) {
 	}
 	sort.Ints(months)
 
-	fmt.Println("\nSelecione o mês (0 para voltar):")
+	fmt.Fprintln(s.out, i18n.T("\nSelecione o mês (0 para voltar):"))
 	for i, m := range months {
-		fmt.Printf("%d. %s\n", i+1, monthNames[m-1])
+		fmt.Fprintf(s.out, "%d. %s\n", i+1, i18n.MonthName(m))
 	}
-	fmt.Print("Mês: ")
-	monthStr, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Mês: "))
+	monthStr, _ := s.readLine()
 	monthStr = strings.TrimSpace(monthStr)
 
 	if monthStr == "0" {
@@ -75,105 +77,76 @@ func listMonths(reader *bufio.Reader, list product.ProductList) {
 
 	monthIdx, err := strconv.Atoi(monthStr)
 	if err != nil || monthIdx < 1 || monthIdx > len(months) {
-		fmt.Println("Mês inválido.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Mês inválido."))
+		s.pause(2 * time.Second)
 		return
 	}
 	month := months[monthIdx-1]
 
-	prodIndexes := monthsMap[month]
-	if len(prodIndexes) == 0 {
-		fmt.Printf("\nNenhum produto encontrado para %s/%d.\n", monthNames[month-1], year)
-		time.Sleep(2 * time.Second)
+	lines := report.ActiveProducts(list, year, month)
+	if len(lines) == 0 {
+		fmt.Fprintf(s.out, i18n.T("\nNenhum produto encontrado para %s/%d.\n"), i18n.MonthName(month), year)
+		s.pause(2 * time.Second)
 		return
 	}
 
-	uniqueIndexes := make([]int, 0)
-	seen := make(map[int]bool)
-	for _, idx := range prodIndexes {
-		if !seen[idx] {
-			seen[idx] = true
-			uniqueIndexes = append(uniqueIndexes, idx)
-		}
-	}
-
-	productsTitle := fmt.Sprintf(" PRODUTOS DE %s/%d ", monthNames[month-1], year)
-	fmt.Println("\n" + divider)
-	fmt.Println(productsTitle)
-	fmt.Println(divider)
+	productsTitle := i18n.Sprintf(" PRODUTOS DE %s/%d ", i18n.MonthName(month), year)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, productsTitle)
+	fmt.Fprintln(s.out, divider)
 
-	for i, idx := range uniqueIndexes {
-		p := list.Products[idx]
-		installmentNumber := getInstallmentNumber(p, year, month)
-		fmt.Printf("%d. %s | Total: R$%.2f | Parcela: R$%.2f (%d/%d)\n",
-			i+1, p.Name, p.TotalValue, p.Parcel, installmentNumber, p.Installments)
+	for i, p := range lines {
+		fmt.Fprintf(s.out, i18n.T("%d. %s | Total: %s | Parcela: %s (%d/%d)%s\n"),
+			i+1, p.Name, totalLabel(p.Currency, p.OriginalValue, p.TotalValue), money.Format(p.Parcel), p.InstallmentNumber, p.Installments, paidMark(p.Paid))
 	}
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, divider)
 
-	fmt.Print("\nPressione Enter para voltar...")
-	reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("\nPressione Enter para voltar..."))
+	s.readLine()
 }
 
-func showProductsByMonth(list product.ProductList, month int, year int) {
+func (s *session) showProductsByMonth(list product.ProductList, month int, year int) {
 	if len(list.Products) == 0 {
-		fmt.Println("Nenhum produto cadastrado.")
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto cadastrado."))
 		return
 	}
 
-	var monthlyProducts []product.Product
-	var totalParcel float64
-
-	targetDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
-
-	for _, p := range list.Products {
-		startDate := p.CreatedAt
-		endDate := startDate.AddDate(0, p.Installments-1, 0)
+	summary := report.Summarize(list, year, month)
+	monthName := i18n.MonthName(month)
 
-		if !targetDate.Before(startDate) && !targetDate.After(endDate) {
-			monthlyProducts = append(monthlyProducts, p)
-			totalParcel += p.Parcel
-		}
-	}
-
-	if len(monthlyProducts) == 0 {
-		monthName := monthNames[month-1]
-		fmt.Printf("Nenhum produto ativo para %s de %d.\n", monthName, year)
+	if len(summary.Products) == 0 {
+		fmt.Fprintf(s.out, i18n.T("Nenhum produto ativo para %s de %d.\n"), monthName, year)
 		return
 	}
 
 	divider := strings.Repeat("-", 60)
-	monthName := monthNames[month-1]
-	title := fmt.Sprintf(" RESUMO DO MÊS (%02d/%d - %s) ", month, year, monthName)
+	title := i18n.Sprintf(" RESUMO DO MÊS (%02d/%d - %s) ", month, year, monthName)
 
-	fmt.Println("\n" + divider)
-	fmt.Println(title)
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
 
-	fmt.Printf("Total de parcelas: R$%.2f\n", totalParcel)
+	fmt.Fprintf(s.out, i18n.T("Total de parcelas: %s\n"), money.Format(summary.TotalParcel))
 
-	if list.MonthlyProfit > 0 {
-		usedPercent := (totalParcel / list.MonthlyProfit) * 100
-		leftPercent := 100 - usedPercent
-		fmt.Printf("Usado: %.2f%% | Para reinvestir: %.2f%%\n", usedPercent, leftPercent)
+	if summary.MonthlyProfit > 0 {
+		fmt.Fprintf(s.out, i18n.T("Usado: %s%% | Para reinvestir: %s%%\n"), money.FormatPercent(summary.UsedPercent, 2), money.FormatPercent(summary.LeftPercent, 2))
 
-		if leftPercent >= list.SafePercentage {
-			fmt.Println("✅ Você pode usar parte do seu lucro para pagar as parcelas!")
+		if summary.Verdict == report.VerdictOK {
+			fmt.Fprintln(s.out, i18n.T("✅ Você pode usar parte do seu lucro para pagar as parcelas!"))
 		} else {
-			fmt.Println("❌ Não recomendado. Crie uma caixinha separada para alguns produtos!")
-			suggestProductsToSeparate(monthlyProducts, list.MonthlyProfit, list.SafePercentage)
+			fmt.Fprintln(s.out, i18n.T("❌ Não recomendado. Crie uma caixinha separada para alguns produtos!"))
+			s.showSuggestedProducts(summary.Suggested, summary.SuggestedTotal)
 		}
 	}
 
-	productsTitle := " PRODUTOS ATIVOS NESTE MÊS "
-	fmt.Println("\n" + divider)
-	fmt.Println(productsTitle)
-	fmt.Println(divider)
-
-	for i, p := range monthlyProducts {
-		installmentNumber := getInstallmentNumber(p, year, month)
+	productsTitle := i18n.T(" PRODUTOS ATIVOS NESTE MÊS ")
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, productsTitle)
+	fmt.Fprintln(s.out, divider)
 
-		fmt.Printf("%d. %s | Total: R$%.2f | Parcela: R$%.2f (%d/%d) | Adicionado em: %s\n",
-			i+1, p.Name, p.TotalValue, p.Parcel, installmentNumber, p.Installments, p.CreatedAt.Format("02/01/2006"))
+	for i, p := range summary.Products {
+		fmt.Fprintf(s.out, i18n.T("%d. %s | Total: %s | Parcela: %s (%d/%d)%s | Adicionado em: %s\n"),
+			i+1, p.Name, totalLabel(p.Currency, p.OriginalValue, p.TotalValue), money.Format(p.Parcel), p.InstallmentNumber, p.Installments, paidMark(p.Paid), i18n.FormatDate(p.CreatedAt))
 	}
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, divider)
 }
@@ -0,0 +1,123 @@
+package menu
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/ofx"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+func (s *session) importOFX(list *product.ProductList) {
+	divider := strings.Repeat("-", 60)
+
+	fmt.Fprint(s.out, i18n.T("Extrato OFX a importar (0 para voltar): "))
+	path, _ := s.readLine()
+	path = strings.TrimSpace(path)
+
+	if path == "0" || path == "" {
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao abrir o arquivo:"), err)
+		s.pause(2 * time.Second)
+		return
+	}
+	stmt, err := ofx.Parse(f)
+	f.Close()
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao ler o extrato:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprint(s.out, i18n.T("Cartão deste extrato (opcional, Enter para deixar em branco): "))
+	card, _ := s.readLine()
+	card = strings.TrimSpace(card)
+
+	entries := ofx.Reconcile(list.Products, stmt, card)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, i18n.T(" CONCILIAÇÃO DO EXTRATO "))
+	fmt.Fprintln(s.out, divider)
+
+	changes := 0
+	for _, status := range []ofx.Status{ofx.StatusMatched, ofx.StatusNew, ofx.StatusAmbiguous, ofx.StatusUnmatched} {
+		var group []ofx.Entry
+		for _, entry := range entries {
+			if entry.Status == status {
+				group = append(group, entry)
+			}
+		}
+
+		switch status {
+		case ofx.StatusMatched:
+			fmt.Fprintf(s.out, i18n.T("\n✅ Conciliadas (%d):\n"), len(group))
+		case ofx.StatusNew:
+			fmt.Fprintf(s.out, i18n.T("\n🆕 Produtos novos (%d):\n"), len(group))
+		case ofx.StatusAmbiguous:
+			fmt.Fprintf(s.out, i18n.T("\n⚠️  Ambíguas, com nome diferente do produto (%d):\n"), len(group))
+		default:
+			fmt.Fprintf(s.out, i18n.T("\n❔ Não conciliadas (%d):\n"), len(group))
+		}
+
+		for _, entry := range group {
+			t := entry.Transaction
+			fmt.Fprintf(s.out, "  %s | %s | %s", i18n.FormatDate(t.Date), t.Memo, money.Format(-t.Amount))
+			switch status {
+			case ofx.StatusMatched:
+				fmt.Fprintf(s.out, i18n.T(" → %s, parcela %d/%d"), entry.Product.Name, entry.Number, entry.Installments)
+				if entry.AlreadyPaid {
+					fmt.Fprint(s.out, i18n.T(" (já paga)"))
+				} else {
+					changes++
+				}
+			case ofx.StatusNew:
+				fmt.Fprintf(s.out, i18n.T(" → '%s' em %dx de %s, parcela %d/%d"), entry.Product.Name,
+					entry.Installments, money.Format(entry.Product.Parcel), entry.Number, entry.Installments)
+				changes++
+			case ofx.StatusAmbiguous:
+				fmt.Fprintf(s.out, i18n.T(" → talvez %s, parcela %d/%d"), entry.Product.Name, entry.Number, entry.Installments)
+			}
+			fmt.Fprintln(s.out)
+		}
+	}
+	fmt.Fprintln(s.out, divider)
+
+	if changes == 0 {
+		fmt.Fprintln(s.out, i18n.T("Nada a atualizar."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprint(s.out, i18n.T("Aplicar a conciliação? (s/n): "))
+	confirm, _ := s.readLine()
+	if !i18n.IsYes(confirm) {
+		fmt.Fprintln(s.out, i18n.T("Operação cancelada."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	if err := ofx.Apply(list, entries, clock.RealNow(s.clock)); err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprintln(s.out, i18n.T("✅ Extrato conciliado!"))
+	s.pause(2 * time.Second)
+}
+
+func paidMark(paid bool) string {
+	if !paid {
+		return ""
+	}
+	return i18n.T(" ✔ paga")
+}
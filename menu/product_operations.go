@@ -1,59 +1,74 @@
 package menu
 
 import (
-	"bufio"
 	"fmt"
-	"math"
-	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
 	"github.com/pedrorcruzz/smart-spending-checker/product"
 )
 
-func addProduct(reader *bufio.Reader, list *product.ProductList) {
-	title := " ADICIONAR PRODUTO "
+func (s *session) addProduct(list *product.ProductList) {
+	title := i18n.T(" ADICIONAR PRODUTO ")
 	divider := strings.Repeat("-", 40)
 
-	fmt.Println("\n" + divider)
-	fmt.Println(title)
-	fmt.Println(divider)
-	fmt.Println("0. Voltar ao Menu")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
 
-	fmt.Print("Nome do produto (0 para voltar): ")
-	name, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Nome do produto (0 para voltar): "))
+	name, _ := s.readLine()
 	name = strings.TrimSpace(name)
 
 	if name == "0" {
 		return
 	}
 
-	if _, err := strconv.Atoi(name); err == nil {
-		fmt.Println("Nome invalido.")
-		time.Sleep(2 * time.Second)
+	if err := product.ValidateName(name); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Nome invalido."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	fmt.Print("Valor total do produto (R$) (0 para voltar): ")
-	valueStr, _ := reader.ReadString('\n')
+	currency, ok := s.readCurrency()
+	if !ok {
+		return
+	}
+
+	if currency == money.BaseCurrency {
+		fmt.Fprint(s.out, i18n.T("Valor total do produto (R$) (0 para voltar): "))
+	} else {
+		fmt.Fprintf(s.out, i18n.T("Valor total do produto (%s) (0 para voltar): "), currency)
+	}
+	valueStr, _ := s.readLine()
 	valueStr = strings.TrimSpace(valueStr)
-	valueStr = strings.ReplaceAll(valueStr, ",", ".")
 
 	if valueStr == "0" {
 		return
 	}
 
-	totalValue, err := strconv.ParseFloat(valueStr, 64)
-	if err != nil {
-		fmt.Println("Valor invalido.")
-		time.Sleep(2 * time.Second)
+	totalValue, err := money.Parse(valueStr)
+	if err != nil || totalValue <= 0 {
+		fmt.Fprintln(s.out, i18n.T("Valor invalido."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	fmt.Print("Em quantas vezes será parcelado (0 para voltar): ")
-	installmentsStr, _ := reader.ReadString('\n')
+	var rate, iof float64
+	if currency != money.BaseCurrency {
+		if rate, iof, ok = s.readExchange(currency); !ok {
+			return
+		}
+	}
+
+	fmt.Fprint(s.out, i18n.T("Em quantas vezes será parcelado (0 para voltar): "))
+	installmentsStr, _ := s.readLine()
 	installmentsStr = strings.TrimSpace(installmentsStr)
 
 	if installmentsStr == "0" {
@@ -62,108 +77,138 @@ func addProduct(reader *bufio.Reader, list *product.ProductList) {
 
 	installments, err := strconv.Atoi(installmentsStr)
 	if err != nil || installments < 1 {
-		fmt.Println("Número de parcelas inválido.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Número de parcelas inválido."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	parcel := totalValue / float64(installments)
+	fmt.Fprint(s.out, i18n.T("Categoria (opcional, Enter para deixar em branco): "))
+	category, _ := s.readLine()
 
-	list.Products = append(list.Products, product.Product{
-		Name:         name,
-		Parcel:       parcel,
-		TotalValue:   totalValue,
-		Installments: installments,
-		CreatedAt:    time.Now(),
-	})
-	list.Month = int(time.Now().Month())
-	list.Year = time.Now().Year()
+	fmt.Fprint(s.out, i18n.T("Cartão (opcional, Enter para deixar em branco): "))
+	card, _ := s.readLine()
 
-	fmt.Println(divider)
-	fmt.Printf("✅ Produto adicionado! Parcela mensal: R$%.2f\n", parcel)
-	fmt.Println(divider)
+	now := s.clock.Now()
+	p, err := product.New(name, totalValue, installments, now)
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+	p.Category = strings.TrimSpace(category)
+	p.Card = strings.TrimSpace(card)
+	if err := p.SetForeign(currency, totalValue, rate, iof); err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
 
-	time.Sleep(2 * time.Second)
+	list.Add(p, clock.RealNow(s.clock))
+	list.Month = int(now.Month())
+	list.Year = now.Year()
+
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("✅ Produto adicionado! Parcela mensal: %s\n"), money.Format(p.Parcel))
+	if p.IsForeign() {
+		fmt.Fprintf(s.out, i18n.T("Convertido: %s = %s (cotação %s, IOF %s%%)\n"),
+			money.FormatIn(p.OriginalValue, p.Currency), money.Format(p.TotalValue),
+			money.FormatNumber(p.ExchangeRate), money.FormatNumber(p.IOF))
+	}
+	fmt.Fprintln(s.out, divider)
+
+	s.pause(2 * time.Second)
 }
 
-func removeProduct(reader *bufio.Reader, list *product.ProductList) {
-	title := " REMOVER PRODUTO "
+func (s *session) removeProduct(list *product.ProductList) {
+	title := i18n.T(" REMOVER PRODUTO ")
 	divider := strings.Repeat("-", 40)
 
-	fmt.Println("\n" + divider)
-	fmt.Println(title)
-	fmt.Println(divider)
-	fmt.Println("0. Voltar ao Menu")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
 
 	if len(list.Products) == 0 {
-		fmt.Println("Nenhum produto para remover.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto para remover."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	idx, ok := selectProductByYearMonth(reader, list.Products)
+	idx, ok := s.selectProductByYearMonth(list.Products)
 	if !ok {
-		time.Sleep(2 * time.Second)
+		s.pause(2 * time.Second)
 		return
 	}
 
-	fmt.Printf("\nTem certeza que deseja remover '%s'? (s/n): ", list.Products[idx].Name)
-	confirm, _ := reader.ReadString('\n')
+	s.removeProductAt(list, idx)
+}
+
+func (s *session) removeProductAt(list *product.ProductList, idx int) {
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprintf(s.out, i18n.T("\nTem certeza que deseja remover '%s'? (s/n): "), list.Products[idx].Name)
+	confirm, _ := s.readLine()
 	confirm = strings.TrimSpace(strings.ToLower(confirm))
-	if confirm != "s" && confirm != "sim" {
-		fmt.Println("Operação cancelada.")
-		time.Sleep(2 * time.Second)
+	if !i18n.IsYes(confirm) {
+		fmt.Fprintln(s.out, i18n.T("Operação cancelada."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	list.Products = slices.Delete(list.Products, idx, idx+1)
+	list.Remove(list.Products[idx].ID, clock.RealNow(s.clock))
 
-	fmt.Println(divider)
-	fmt.Println("✅ Produto removido!")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("✅ Produto removido!"))
+	fmt.Fprintln(s.out, divider)
 
-	time.Sleep(2 * time.Second)
+	s.pause(2 * time.Second)
 }
 
-func editProduct(reader *bufio.Reader, list *product.ProductList) {
-	title := " EDITAR PRODUTO "
+func (s *session) editProduct(list *product.ProductList) {
+	title := i18n.T(" EDITAR PRODUTO ")
 	divider := strings.Repeat("-", 40)
 
-	fmt.Println("\n" + divider)
-	fmt.Println(title)
-	fmt.Println(divider)
-	fmt.Println("0. Voltar ao Menu")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
 
 	if len(list.Products) == 0 {
-		fmt.Println("Nenhum produto para editar.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto para editar."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	idx, ok := selectProductByYearMonth(reader, list.Products)
+	idx, ok := s.selectProductByYearMonth(list.Products)
 	if !ok {
-		time.Sleep(2 * time.Second)
+		s.pause(2 * time.Second)
 		return
 	}
 
-	p := &list.Products[idx]
+	s.editProductAt(list, idx)
+}
 
-	fmt.Printf("Nome atual: %s. Novo nome (ou Enter para manter, 0 para voltar): ", p.Name)
-	newName, _ := reader.ReadString('\n')
+func (s *session) editProductAt(list *product.ProductList, idx int) {
+	divider := strings.Repeat("-", 40)
+	p := list.Products[idx]
+
+	fmt.Fprintf(s.out, i18n.T("Nome atual: %s. Novo nome (ou Enter para manter, 0 para voltar): "), p.Name)
+	newName, _ := s.readLine()
 	newName = strings.TrimSpace(newName)
 
 	if newName == "0" {
 		return
 	}
 
-	if newName != "" {
+	if newName != "" && product.ValidateName(newName) == nil {
 		p.Name = newName
 	}
 
-	fmt.Printf("Valor total atual: R$%.2f. Novo valor (ou Enter para manter, 0 para voltar): ", p.TotalValue)
-	totalValueStr, _ := reader.ReadString('\n')
+	fmt.Fprintf(s.out, i18n.T("Valor total atual: %s. Novo valor (ou Enter para manter, 0 para voltar): "),
+		totalLabel(p.Currency, p.OriginalValue, p.TotalValue))
+	totalValueStr, _ := s.readLine()
 	totalValueStr = strings.TrimSpace(totalValueStr)
 
 	if totalValueStr == "0" {
@@ -171,15 +216,14 @@ func editProduct(reader *bufio.Reader, list *product.ProductList) {
 	}
 
 	if totalValueStr != "" {
-		totalValueStr = strings.ReplaceAll(totalValueStr, ",", ".")
-		totalValue, err := strconv.ParseFloat(totalValueStr, 64)
+		totalValue, err := money.Parse(totalValueStr)
 		if err == nil && totalValue > 0 {
-			p.TotalValue = totalValue
+			p.SetAmount(totalValue)
 		}
 	}
 
-	fmt.Printf("Parcelas atuais: %d. Novo número de parcelas (ou Enter para manter, 0 para voltar): ", p.Installments)
-	installmentsStr, _ := reader.ReadString('\n')
+	fmt.Fprintf(s.out, i18n.T("Parcelas atuais: %d. Novo número de parcelas (ou Enter para manter, 0 para voltar): "), p.Installments)
+	installmentsStr, _ := s.readLine()
 	installmentsStr = strings.TrimSpace(installmentsStr)
 
 	if installmentsStr == "0" {
@@ -193,54 +237,77 @@ func editProduct(reader *bufio.Reader, list *product.ProductList) {
 		}
 	}
 
+	fmt.Fprintf(s.out, i18n.T("Categoria atual: %s. Nova categoria (ou Enter para manter, - para limpar): "), categoryLabel(p.Category))
+	category, _ := s.readLine()
+	category = strings.TrimSpace(category)
+
+	if category == "-" {
+		p.Category = ""
+	} else if category != "" {
+		p.Category = category
+	}
+
+	fmt.Fprintf(s.out, i18n.T("Cartão atual: %s. Novo cartão (ou Enter para manter, - para limpar): "), cardLabel(p.Card))
+	card, _ := s.readLine()
+	card = strings.TrimSpace(card)
+
+	if card == "-" {
+		p.Card = ""
+	} else if card != "" {
+		p.Card = card
+	}
+
 	p.Parcel = p.TotalValue / float64(p.Installments)
+	list.Update(p, clock.RealNow(s.clock))
 
-	fmt.Println(divider)
-	fmt.Println("✅ Produto atualizado!")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("✅ Produto atualizado!"))
+	fmt.Fprintln(s.out, divider)
 
-	time.Sleep(2 * time.Second)
+	s.pause(2 * time.Second)
 }
 
-func anticipateInstallments(reader *bufio.Reader, list *product.ProductList) {
-	title := " ANTECIPAR PARCELAS "
+func (s *session) anticipateInstallments(list *product.ProductList) {
+	title := i18n.T(" ANTECIPAR PARCELAS ")
 	divider := strings.Repeat("-", 40)
 
-	fmt.Println("\n" + divider)
-	fmt.Println(title)
-	fmt.Println(divider)
-	fmt.Println("0. Voltar ao Menu")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
 
 	if len(list.Products) == 0 {
-		fmt.Println("Nenhum produto para antecipar parcelas.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto para antecipar parcelas."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	idx, ok := selectProductByYearMonth(reader, list.Products)
+	idx, ok := s.selectProductByYearMonth(list.Products)
 	if !ok {
-		time.Sleep(2 * time.Second)
+		s.pause(2 * time.Second)
 		return
 	}
 
+	s.anticipateProductAt(list, idx)
+}
+
+func (s *session) anticipateProductAt(list *product.ProductList, idx int) {
+	divider := strings.Repeat("-", 40)
 	p := &list.Products[idx]
 
-	now := time.Now()
-	monthsElapsed := int(now.Sub(p.CreatedAt).Hours()/24/30) + 1
-	if monthsElapsed = int(math.Min(float64(monthsElapsed), float64(p.Installments))); monthsElapsed > p.Installments {
-		monthsElapsed = p.Installments
-	}
+	now := s.clock.Now()
+	currentInstallment := p.InstallmentNumber(now.Year(), int(now.Month()))
 
-	remainingInstallments := p.Installments - monthsElapsed + 1
+	remainingInstallments := p.Installments - currentInstallment + 1
 	if remainingInstallments <= 0 {
-		fmt.Println("Este produto já foi totalmente pago.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Este produto já foi totalmente pago."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	fmt.Printf("Quantas parcelas deseja antecipar? (Total restante: %d, 0 para voltar): ", remainingInstallments)
-	anticipateStr, _ := reader.ReadString('\n')
+	fmt.Fprintf(s.out, i18n.T("Quantas parcelas deseja antecipar? (Total restante: %d, 0 para voltar): "), remainingInstallments)
+	anticipateStr, _ := s.readLine()
 	anticipateStr = strings.TrimSpace(anticipateStr)
 
 	if anticipateStr == "0" {
@@ -249,85 +316,85 @@ func anticipateInstallments(reader *bufio.Reader, list *product.ProductList) {
 
 	anticipate, err := strconv.Atoi(anticipateStr)
 	if err != nil || anticipate < 1 || anticipate > remainingInstallments {
-		fmt.Println("Quantidade inválida.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Quantidade inválida."))
+		s.pause(2 * time.Second)
 		return
 	}
 
 	valorTotal := float64(anticipate) * p.Parcel
 
-	fmt.Println(divider)
-	fmt.Printf("Valor total para antecipar %d parcelas: R$%.2f\n", anticipate, valorTotal)
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("Valor total para antecipar %d parcelas: %s\n"), anticipate, money.Format(valorTotal))
+	fmt.Fprintln(s.out, divider)
 
-	fmt.Print("Deseja confirmar a antecipação? (s/n): ")
-	confirm, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Deseja confirmar a antecipação? (s/n): "))
+	confirm, _ := s.readLine()
 	confirm = strings.TrimSpace(strings.ToLower(confirm))
-	if confirm != "s" && confirm != "sim" {
-		fmt.Println("Operação cancelada.")
-		time.Sleep(2 * time.Second)
+	if !i18n.IsYes(confirm) {
+		fmt.Fprintln(s.out, i18n.T("Operação cancelada."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	p.Installments -= anticipate
+	list.Anticipate(p.ID, anticipate, clock.RealNow(s.clock))
 
-	fmt.Println("✅ Parcelas antecipadas com sucesso!")
-	time.Sleep(2 * time.Second)
+	fmt.Fprintln(s.out, i18n.T("✅ Parcelas antecipadas com sucesso!"))
+	s.pause(2 * time.Second)
 }
 
-func updateMonthlyProfit(reader *bufio.Reader, list *product.ProductList) {
-	title := " ATUALIZAR LUCRO MENSAL "
+func (s *session) updateMonthlyProfit(list *product.ProductList) {
+	title := i18n.T(" ATUALIZAR LUCRO MENSAL ")
 	divider := strings.Repeat("-", 40)
 
-	fmt.Println("\n" + divider)
-	fmt.Println(title)
-	fmt.Println(divider)
-	fmt.Println("0. Voltar ao Menu")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
 
-	fmt.Print("Novo lucro mensal (R$) (0 para voltar): ")
-	valueStr, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Novo lucro mensal (R$) (0 para voltar): "))
+	valueStr, _ := s.readLine()
 	valueStr = strings.TrimSpace(valueStr)
-	valueStr = strings.ReplaceAll(valueStr, ",", ".")
 
 	if valueStr == "0" {
 		return
 	}
 
-	profit, err := strconv.ParseFloat(valueStr, 64)
-	if err != nil {
-		fmt.Println("Valor invalido.")
-		time.Sleep(2 * time.Second)
+	profit, err := money.Parse(valueStr)
+	if err != nil || product.ValidateMonthlyProfit(profit) != nil {
+		fmt.Fprintln(s.out, i18n.T("Valor invalido."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	list.MonthlyProfit = profit
-	list.Month = int(time.Now().Month())
-	list.Year = time.Now().Year()
+	now := s.clock.Now()
+	list.SetMonthlyProfit(profit, clock.RealNow(s.clock))
+	list.Month = int(now.Month())
+	list.Year = now.Year()
 
-	fmt.Println(divider)
-	fmt.Println("✅ Lucro mensal atualizado!")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("✅ Lucro mensal atualizado!"))
+	fmt.Fprintln(s.out, divider)
 
-	time.Sleep(2 * time.Second)
+	s.pause(2 * time.Second)
 }
 
-func configureSafePercentage(reader *bufio.Reader, list *product.ProductList) {
-	title := " CONFIGURAR PORCENTAGEM SEGURA "
+func (s *session) configureSafePercentage(list *product.ProductList) {
+	title := i18n.T(" CONFIGURAR PORCENTAGEM SEGURA ")
 	divider := strings.Repeat("-", 50)
 
-	fmt.Println("\n" + divider)
-	fmt.Println(title)
-	fmt.Println(divider)
-	fmt.Println("0. Voltar ao Menu")
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
 
-	fmt.Println("A porcentagem segura define quanto do seu lucro mensal deve estar disponível para reinvestimento.")
-	fmt.Println("Recomendação: Mantenha pelo menos 70% do seu lucro disponível para reinvestimento.")
-	fmt.Printf("Porcentagem atual: %.0f%%\n", list.SafePercentage)
+	fmt.Fprintln(s.out, i18n.T("A porcentagem segura define quanto do seu lucro mensal deve estar disponível para reinvestimento."))
+	fmt.Fprintln(s.out, i18n.T("Recomendação: Mantenha pelo menos 70% do seu lucro disponível para reinvestimento."))
+	fmt.Fprintf(s.out, i18n.T("Porcentagem atual: %s%%\n"), money.FormatPercent(list.SafePercentage, 0))
 
-	fmt.Print("Nova porcentagem segura (ou Enter para manter, 0 para voltar): ")
-	percentageStr, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Nova porcentagem segura (ou Enter para manter, 0 para voltar): "))
+	percentageStr, _ := s.readLine()
 	percentageStr = strings.TrimSpace(percentageStr)
 
 	if percentageStr == "0" {
@@ -335,24 +402,23 @@ func configureSafePercentage(reader *bufio.Reader, list *product.ProductList) {
 	}
 
 	if percentageStr == "" {
-		fmt.Println("Mantendo a porcentagem atual.")
-		time.Sleep(2 * time.Second)
+		fmt.Fprintln(s.out, i18n.T("Mantendo a porcentagem atual."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	percentageStr = strings.ReplaceAll(percentageStr, ",", ".")
-	percentage, err := strconv.ParseFloat(percentageStr, 64)
-	if err != nil || percentage <= 0 || percentage > 100 {
-		fmt.Println("Valor inválido. Mantendo a porcentagem atual.")
-		time.Sleep(2 * time.Second)
+	percentage, err := money.Parse(percentageStr)
+	if err != nil || product.ValidateSafePercentage(percentage) != nil {
+		fmt.Fprintln(s.out, i18n.T("Valor inválido. Mantendo a porcentagem atual."))
+		s.pause(2 * time.Second)
 		return
 	}
 
-	list.SafePercentage = percentage
+	list.SetSafePercentage(percentage, clock.RealNow(s.clock))
 
-	fmt.Println(divider)
-	fmt.Printf("✅ Porcentagem segura atualizada para %.0f%%!\n", percentage)
-	fmt.Println(divider)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("✅ Porcentagem segura atualizada para %s%%!\n"), money.FormatPercent(percentage, 0))
+	fmt.Fprintln(s.out, divider)
 
-	time.Sleep(2 * time.Second)
+	s.pause(2 * time.Second)
 }
@@ -0,0 +1,172 @@
+package menu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+)
+
+func (s *session) manageProfiles(profile *string) bool {
+	title := i18n.T(" PERFIS ")
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("Perfil atual: %s\n"), *profile)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("1. Trocar perfil"))
+	fmt.Fprintln(s.out, i18n.T("2. Criar perfil"))
+	fmt.Fprintln(s.out, i18n.T("3. Visão combinada"))
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choice, _ := s.readLine()
+	choice = strings.TrimSpace(choice)
+
+	switch choice {
+	case "0":
+		return false
+	case "1":
+		return s.switchProfile(profile)
+	case "2":
+		return s.createProfile(profile)
+	case "3":
+		s.showCombinedSummary()
+		return false
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+		return false
+	}
+}
+
+func (s *session) switchProfile(profile *string) bool {
+	profiles, err := storage.ListProfiles()
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao listar perfis:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return false
+	}
+
+	fmt.Fprintln(s.out, i18n.T("\nSelecione o perfil (0 para voltar):"))
+	for i, name := range profiles {
+		marker := ""
+		if name == *profile {
+			marker = i18n.T(" (atual)")
+		}
+		fmt.Fprintf(s.out, "%d. %s%s\n", i+1, name, marker)
+	}
+	fmt.Fprint(s.out, i18n.T("Perfil: "))
+	profileStr, _ := s.readLine()
+	profileStr = strings.TrimSpace(profileStr)
+
+	if profileStr == "0" {
+		return false
+	}
+
+	profileIdx, err := strconv.Atoi(profileStr)
+	if err != nil || profileIdx < 1 || profileIdx > len(profiles) {
+		fmt.Fprintln(s.out, i18n.T("Perfil inválido."))
+		s.pause(2 * time.Second)
+		return false
+	}
+
+	return s.activateProfile(profiles[profileIdx-1], profile)
+}
+
+func (s *session) createProfile(profile *string) bool {
+	fmt.Fprint(s.out, i18n.T("\nNome do novo perfil (letras, números, - e _) (0 para voltar): "))
+	name, _ := s.readLine()
+	name = strings.TrimSpace(name)
+
+	if name == "0" {
+		return false
+	}
+
+	if err := storage.CreateProfile(name); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Não foi possível criar o perfil:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return false
+	}
+
+	fmt.Fprintf(s.out, i18n.T("✅ Perfil '%s' criado!\n"), name)
+	return s.activateProfile(name, profile)
+}
+
+func (s *session) activateProfile(name string, profile *string) bool {
+	config, _ := storage.LoadConfig()
+	config.ActiveProfile = name
+	if err := storage.SaveConfig(config); err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao salvar configuração:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return false
+	}
+
+	*profile = name
+	fmt.Fprintf(s.out, i18n.T("✅ Perfil ativo: %s\n"), name)
+	s.pause(2 * time.Second)
+	return true
+}
+
+func (s *session) showCombinedSummary() {
+	profiles, err := storage.ListProfiles()
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.T("Erro ao listar perfis:"), i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	now := s.clock.Now()
+	targetYear := now.Year()
+	targetMonth := int(now.Month())
+
+	divider := strings.Repeat("-", 60)
+	title := i18n.Sprintf(" VISÃO COMBINADA (%02d/%d - %s) ", targetMonth, targetYear, i18n.MonthName(targetMonth))
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+
+	var summaries []report.MonthSummary
+
+	for _, name := range profiles {
+		if !s.unlockProfile(name) {
+			fmt.Fprintf(s.out, i18n.T("%s | 🔒 Perfil bloqueado\n"), name)
+			continue
+		}
+
+		list, err := storage.LoadProducts(name)
+		if err != nil {
+			fmt.Fprintf(s.out, i18n.T("%s | Erro ao carregar: %v\n"), name, err)
+			continue
+		}
+
+		summary := report.Summarize(list, targetYear, targetMonth)
+		summaries = append(summaries, summary)
+
+		fmt.Fprintf(s.out, i18n.T("%s | Lucro: %s | Parcelas: %s | Usado: %s%% | Produtos ativos: %d\n"),
+			name, money.Format(summary.MonthlyProfit), money.Format(summary.TotalParcel), money.FormatPercent(summary.UsedPercent, 2), len(summary.Products))
+	}
+
+	total := report.Combine(targetYear, targetMonth, summaries...)
+
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("TOTAL | Lucro: %s | Parcelas: %s | Usado: %s%% | Produtos ativos: %d\n"),
+		money.Format(total.MonthlyProfit), money.Format(total.TotalParcel), money.FormatPercent(total.UsedPercent, 2), len(total.Products))
+	if total.Verdict == report.VerdictOK {
+		fmt.Fprintln(s.out, i18n.T("✅ Somando todos os perfis, as parcelas estão dentro da porcentagem segura."))
+	} else {
+		fmt.Fprintln(s.out, i18n.T("❌ Somando todos os perfis, as parcelas ultrapassam a porcentagem segura."))
+	}
+	fmt.Fprintln(s.out, divider)
+
+	fmt.Fprint(s.out, i18n.T("\nPressione Enter para voltar..."))
+	s.readLine()
+}
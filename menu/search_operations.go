@@ -0,0 +1,115 @@
+package menu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+const maxSearchResults = 20
+
+func (s *session) searchProducts(list *product.ProductList) {
+	title := i18n.T(" BUSCAR PRODUTO ")
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+
+	if len(list.Products) == 0 {
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto cadastrado."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	fmt.Fprint(s.out, i18n.T("Buscar por nome, categoria ou valor (0 para voltar): "))
+	query, _ := s.readLine()
+	query = strings.TrimSpace(query)
+
+	if query == "0" || query == "" {
+		return
+	}
+
+	results := list.Search(query)
+	if len(results) == 0 {
+		fmt.Fprintf(s.out, i18n.T("Nenhum produto encontrado para '%s'.\n"), query)
+		s.pause(2 * time.Second)
+		return
+	}
+	if len(results) > maxSearchResults {
+		fmt.Fprintf(s.out, i18n.T("%d produtos encontrados, mostrando os %d mais relevantes.\n"), len(results), maxSearchResults)
+		results = results[:maxSearchResults]
+	}
+
+	now := s.clock.Now()
+	fmt.Fprintln(s.out, i18n.T("\nSelecione o produto (0 para voltar):"))
+	for i, idx := range results {
+		p := list.Products[idx]
+		status := i18n.T("quitado")
+		if p.IsActiveInMonth(now.Year(), int(now.Month())) {
+			status = i18n.Sprintf("parcela %d/%d", p.InstallmentNumber(now.Year(), int(now.Month())), p.Installments)
+		} else if y, m := p.InstallmentMonth(1); y*12+m > now.Year()*12+int(now.Month()) {
+			status = i18n.Sprintf("começa em %02d/%d", m, y)
+		}
+		fmt.Fprintf(s.out, i18n.T("%d. %s | %s | Total: %s | Parcela: %s | %s | Adicionado em: %s\n"),
+			i+1, p.Name, categoryLabel(p.Category), totalLabel(p.Currency, p.OriginalValue, p.TotalValue), money.Format(p.Parcel), status, i18n.FormatDate(p.CreatedAt))
+	}
+	fmt.Fprint(s.out, i18n.T("Produto: "))
+	choiceStr, _ := s.readLine()
+	choiceStr = strings.TrimSpace(choiceStr)
+
+	if choiceStr == "0" {
+		return
+	}
+
+	choice, err := strconv.Atoi(choiceStr)
+	if err != nil || choice < 1 || choice > len(results) {
+		fmt.Fprintln(s.out, i18n.T("Produto inválido."))
+		s.pause(2 * time.Second)
+		return
+	}
+	idx := results[choice-1]
+
+	fmt.Fprintf(s.out, i18n.T("\nO que deseja fazer com '%s'?\n"), list.Products[idx].Name)
+	fmt.Fprintln(s.out, i18n.T("1. Editar"))
+	fmt.Fprintln(s.out, i18n.T("2. Remover"))
+	fmt.Fprintln(s.out, i18n.T("3. Antecipar parcelas"))
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	action, _ := s.readLine()
+
+	switch strings.TrimSpace(action) {
+	case "0":
+		return
+	case "1":
+		s.editProductAt(list, idx)
+	case "2":
+		s.removeProductAt(list, idx)
+	case "3":
+		s.anticipateProductAt(list, idx)
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+	}
+}
+
+func categoryLabel(category string) string {
+	if category == "" {
+		return i18n.T("sem categoria")
+	}
+	return category
+}
+
+func cardLabel(card string) string {
+	if card == "" {
+		return i18n.T("sem cartão")
+	}
+	return card
+}
@@ -0,0 +1,81 @@
+package menu
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/storage"
+	"github.com/pedrorcruzz/smart-spending-checker/utils"
+)
+
+type Options struct {
+	In      io.Reader
+	Out     io.Writer
+	Clear   func()
+	Pause   func(time.Duration)
+	Clock   clock.Clock
+	DataDir string
+	Profile string
+}
+
+type session struct {
+	in     io.Reader
+	reader *bufio.Reader
+	out    io.Writer
+	clear  func()
+	pause  func(time.Duration)
+	clock  clock.Clock
+	eof    bool
+}
+
+func NoPause(time.Duration) {}
+
+func NoClear() {}
+
+func newSession(opts Options) *session {
+	if opts.In == nil {
+		opts.In = os.Stdin
+	}
+	if opts.Out == nil {
+		opts.Out = os.Stdout
+	}
+	if opts.Clear == nil {
+		opts.Clear = NoClear
+		if opts.Out == os.Stdout {
+			opts.Clear = utils.ClearTerminal
+		}
+	}
+	if opts.Pause == nil {
+		opts.Pause = time.Sleep
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.System{}
+	}
+	if opts.DataDir != "" {
+		storage.SetDataDir(opts.DataDir)
+	}
+
+	return &session{
+		in:     opts.In,
+		reader: bufio.NewReader(opts.In),
+		out:    opts.Out,
+		clear:  opts.Clear,
+		pause:  opts.Pause,
+		clock:  opts.Clock,
+	}
+}
+
+func (s *session) readLine() (string, error) {
+	line, err := s.reader.ReadString('\n')
+	if err == io.EOF {
+		s.eof = true
+	}
+	return line, err
+}
+
+func (s *session) readPassword() string {
+	return utils.ReadPassword(s.in, s.reader, s.out)
+}
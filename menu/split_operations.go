@@ -0,0 +1,315 @@
+package menu
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+)
+
+func (s *session) manageSplits(list *product.ProductList) {
+	title := i18n.T(" DIVISÃO DE COMPRAS ")
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("1. Pessoas e rendas"))
+	fmt.Fprintln(s.out, i18n.T("2. Dividir produto"))
+	fmt.Fprintln(s.out, i18n.T("3. Resumo do mês por pessoa"))
+	fmt.Fprintln(s.out, i18n.T("4. Quem deve a quem"))
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choice, _ := s.readLine()
+
+	switch strings.TrimSpace(choice) {
+	case "0":
+		return
+	case "1":
+		s.clear()
+		s.managePeople(list)
+	case "2":
+		s.clear()
+		s.splitProduct(list)
+	case "3":
+		s.clear()
+		s.showPersonSummary(*list)
+	case "4":
+		s.clear()
+		s.showSettlement(*list)
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+	}
+}
+
+func (s *session) managePeople(list *product.ProductList) {
+	title := i18n.T(" PESSOAS ")
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("%s (titular) | Renda: %s\n"), personLabel(list.Owner), money.Format(list.MonthlyProfit))
+	for _, person := range list.People {
+		fmt.Fprintf(s.out, i18n.T("%s | Renda: %s\n"), person.Name, money.Format(person.MonthlyProfit))
+	}
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintln(s.out, i18n.T("1. Adicionar ou atualizar pessoa"))
+	fmt.Fprintln(s.out, i18n.T("2. Remover pessoa"))
+	fmt.Fprintln(s.out, i18n.T("3. Renomear titular"))
+	fmt.Fprintln(s.out, i18n.T("0. Voltar ao Menu"))
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprint(s.out, i18n.T("Escolha uma opcão: "))
+	choice, _ := s.readLine()
+
+	var err error
+	switch strings.TrimSpace(choice) {
+	case "0":
+		return
+	case "1":
+		fmt.Fprint(s.out, i18n.T("Nome: "))
+		name, _ := s.readLine()
+		if isOwnerLabel(name) {
+			err = product.ErrOwnerIncome
+			break
+		}
+		var income float64
+		if income, err = s.readFloat(i18n.T("Renda mensal: ")); err != nil {
+			err = product.ErrInvalidValue
+			break
+		}
+		err = list.SetPerson(name, income, clock.RealNow(s.clock))
+	case "2":
+		fmt.Fprint(s.out, i18n.T("Nome: "))
+		name, _ := s.readLine()
+		err = list.RemovePerson(name, clock.RealNow(s.clock))
+	case "3":
+		fmt.Fprint(s.out, i18n.T("Nome do titular: "))
+		name, _ := s.readLine()
+		err = list.SetOwner(name, clock.RealNow(s.clock))
+	default:
+		fmt.Fprintln(s.out, i18n.T("Opcão inválida."))
+		s.pause(1 * time.Second)
+		return
+	}
+
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+	} else {
+		fmt.Fprintln(s.out, i18n.T("✅ Pessoas atualizadas!"))
+	}
+	s.pause(2 * time.Second)
+}
+
+func (s *session) splitProduct(list *product.ProductList) {
+	title := i18n.T(" DIVIDIR PRODUTO ")
+	divider := strings.Repeat("-", 40)
+
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, title)
+	fmt.Fprintln(s.out, divider)
+
+	if len(list.People) == 0 {
+		fmt.Fprintln(s.out, i18n.T("Cadastre antes as pessoas que dividem as compras."))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	idx, ok := s.selectProductByYearMonth(list.Products)
+	if !ok {
+		s.pause(2 * time.Second)
+		return
+	}
+	p := list.Products[idx]
+
+	fmt.Fprintf(s.out, i18n.T("\nDivisão atual: %s\n"), splitLabel(*list, p))
+	var names []string
+	for _, name := range list.PersonNames() {
+		names = append(names, personLabel(name))
+	}
+	fmt.Fprintf(s.out, i18n.T("Pessoas: %s\n"), strings.Join(names, ", "))
+	fmt.Fprintf(s.out, i18n.T("Quem pagou? (Enter para %s): "), personLabel(list.DisplayName(p.PaidBy)))
+	paidBy, _ := s.readLine()
+	paidBy = strings.TrimSpace(paidBy)
+	if paidBy == "" {
+		paidBy = p.PaidBy
+	}
+
+	fmt.Fprintln(s.out, i18n.T("Informe as partes; o que sobrar fica com quem pagou."))
+	var shares []product.Share
+	for {
+		fmt.Fprint(s.out, i18n.T("Pessoa (Enter para terminar): "))
+		person, _ := s.readLine()
+		person = strings.TrimSpace(person)
+		if person == "" {
+			break
+		}
+		key, ok := list.PersonKey(personInput(person))
+		if !ok {
+			fmt.Fprintln(s.out, i18n.Error(product.ErrPersonNotFound))
+			continue
+		}
+
+		fmt.Fprintf(s.out, i18n.T("Parte de %s (ex.: 50%% ou 120,00): "), personLabel(list.DisplayName(key)))
+		value, _ := s.readLine()
+		share, err := product.ParseShare(key, value)
+		if err != nil {
+			fmt.Fprintln(s.out, i18n.Error(err))
+			continue
+		}
+		shares = append(shares, share)
+	}
+
+	if err := list.SetSplit(p.ID, personInput(paidBy), shares, clock.RealNow(s.clock)); err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	idx, _ = list.FindByID(p.ID)
+	p = list.Products[idx]
+	fmt.Fprintln(s.out, divider)
+	fmt.Fprintf(s.out, i18n.T("✅ Divisão atualizada: %s\n"), splitLabel(*list, p))
+	for _, key := range p.Participants() {
+		fmt.Fprintf(s.out, i18n.T("%s: %s por parcela\n"), personLabel(list.DisplayName(key)), money.Format(p.Parcel*p.ShareOf(key)))
+	}
+	fmt.Fprintln(s.out, divider)
+	s.pause(2 * time.Second)
+}
+
+func (s *session) showPersonSummary(list product.ProductList) {
+	names := list.PersonNames()
+	fmt.Fprintln(s.out, i18n.T("\nSelecione a pessoa (0 para voltar):"))
+	for i, name := range names {
+		fmt.Fprintf(s.out, "%d. %s\n", i+1, personLabel(name))
+	}
+	fmt.Fprint(s.out, i18n.T("Pessoa: "))
+	choice, _ := s.readLine()
+	choice = strings.TrimSpace(choice)
+	if choice == "0" {
+		return
+	}
+
+	number, err := strconv.Atoi(choice)
+	if err != nil || number < 1 || number > len(names) {
+		fmt.Fprintln(s.out, i18n.Error(product.ErrPersonNotFound))
+		s.pause(2 * time.Second)
+		return
+	}
+
+	now := s.clock.Now()
+	summary, err := report.SummarizePerson(list, names[number-1], now.Year(), int(now.Month()))
+	if err != nil {
+		fmt.Fprintln(s.out, i18n.Error(err))
+		s.pause(2 * time.Second)
+		return
+	}
+	summary.Person = personLabel(summary.Person)
+	s.clear()
+	s.printSummary(summary)
+
+	fmt.Fprint(s.out, i18n.T("\nPressione Enter para voltar..."))
+	s.readLine()
+}
+
+func (s *session) showSettlement(list product.ProductList) {
+	fmt.Fprint(s.out, i18n.T("Quantos meses a partir deste (Enter para 1): "))
+	monthsStr, _ := s.readLine()
+	monthsStr = strings.TrimSpace(monthsStr)
+
+	months := 1
+	if monthsStr != "" {
+		var err error
+		months, err = strconv.Atoi(monthsStr)
+		if err != nil || months < 1 || months > 120 {
+			fmt.Fprintln(s.out, i18n.T("Quantidade de meses inválida."))
+			s.pause(2 * time.Second)
+			return
+		}
+	}
+
+	now := s.clock.Now()
+	settlement := report.Settle(list, now.Year(), int(now.Month()), months)
+
+	divider := strings.Repeat("-", 60)
+	s.clear()
+	fmt.Fprintln(s.out, "\n"+divider)
+	fmt.Fprintln(s.out, i18n.T(" QUEM DEVE A QUEM "))
+	fmt.Fprintln(s.out, divider)
+
+	if len(settlement.Items) == 0 {
+		fmt.Fprintln(s.out, i18n.T("Nenhuma compra dividida no período."))
+	}
+	for _, item := range settlement.Items {
+		fmt.Fprintf(s.out, "%02d/%d | %s (%d/%d) | %s → %s: %s\n", item.Month, item.Year, item.Product,
+			item.InstallmentNumber, item.Installments, personLabel(item.From), personLabel(item.To), money.Format(item.Amount))
+	}
+
+	if len(settlement.Items) > 0 {
+		fmt.Fprintln(s.out, divider)
+		for _, b := range settlement.Balances {
+			fmt.Fprintf(s.out, i18n.T("%s | Pagou: %s | Parte: %s | Saldo: %s\n"),
+				personLabel(b.Person), money.Format(b.Paid), money.Format(b.Share), money.Format(b.Net))
+		}
+		fmt.Fprintln(s.out, divider)
+		for _, transfer := range settlement.Transfers {
+			fmt.Fprintf(s.out, i18n.T("💸 %s deve %s a %s\n"), personLabel(transfer.From), money.Format(transfer.Amount), personLabel(transfer.To))
+		}
+		if len(settlement.Transfers) == 0 {
+			fmt.Fprintln(s.out, i18n.T("Ninguém deve nada."))
+		}
+	}
+	fmt.Fprintln(s.out, divider)
+
+	fmt.Fprint(s.out, i18n.T("\nPressione Enter para voltar..."))
+	s.readLine()
+}
+
+func splitLabel(list product.ProductList, p product.Product) string {
+	if !p.IsShared() {
+		return i18n.T("não dividido")
+	}
+
+	var parts []string
+	for _, share := range p.Split {
+		parts = append(parts, personLabel(list.DisplayName(share.Person))+" "+shareValueLabel(share))
+	}
+	label := i18n.Sprintf("pago por %s", personLabel(list.DisplayName(p.PaidBy)))
+	if len(parts) > 0 {
+		label += " | " + strings.Join(parts, ", ")
+	}
+	return label
+}
+
+func shareValueLabel(share product.Share) string {
+	if share.Amount > 0 {
+		return money.Format(share.Amount)
+	}
+	if share.Percent == math.Trunc(share.Percent) {
+		return strconv.Itoa(int(share.Percent)) + "%"
+	}
+	return money.FormatNumber(share.Percent) + "%"
+}
+
+func shareLabel(p report.ProductLine) string {
+	if p.Share == 0 {
+		return ""
+	}
+	return i18n.Sprintf(" | Sua parte: %s", money.Format(p.Share))
+}
+
+func ownParcel(p report.ProductLine) float64 {
+	if p.Share > 0 {
+		return p.Share
+	}
+	return p.Parcel
+}
@@ -1,41 +1,56 @@
 package menu
 
 import (
-	"bufio"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
-	"time"
 
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
 	"github.com/pedrorcruzz/smart-spending-checker/product"
 )
 
+func personLabel(name string) string {
+	if name == "" {
+		return i18n.T("Eu")
+	}
+	return name
+}
+
+func isOwnerLabel(name string) bool {
+	for _, label := range i18n.Variants("Eu") {
+		if product.SamePerson(name, label) {
+			return true
+		}
+	}
+	return false
+}
+
+func personInput(name string) string {
+	if isOwnerLabel(name) {
+		return ""
+	}
+	return name
+}
+
 func mapProductsByYearMonth(products []product.Product) map[int]map[int][]int {
 	result := make(map[int]map[int][]int)
 	for idx, p := range products {
-		endDate := p.CreatedAt.AddDate(0, p.Installments-1, 0)
-		currentDate := p.CreatedAt
-
-		for !currentDate.After(endDate) {
-			currentYear := currentDate.Year()
-			currentMonth := int(currentDate.Month())
-
-			if _, ok := result[currentYear]; !ok {
-				result[currentYear] = make(map[int][]int)
+		for _, installment := range p.Schedule() {
+			if _, ok := result[installment.Year]; !ok {
+				result[installment.Year] = make(map[int][]int)
 			}
-			result[currentYear][currentMonth] = append(result[currentYear][currentMonth], idx)
-
-			currentDate = currentDate.AddDate(0, 1, 0)
+			result[installment.Year][installment.Month] = append(result[installment.Year][installment.Month], idx)
 		}
 	}
 	return result
 }
 
-func selectProductByYearMonth(reader *bufio.Reader, products []product.Product) (int, bool) {
+func (s *session) selectProductByYearMonth(products []product.Product) (int, bool) {
 	byYearMonth := mapProductsByYearMonth(products)
 	if len(byYearMonth) == 0 {
-		fmt.Println("Nenhum produto cadastrado.")
+		fmt.Fprintln(s.out, i18n.T("Nenhum produto cadastrado."))
 		return -1, false
 	}
 
@@ -45,12 +60,12 @@ func selectProductByYearMonth(reader *bufio.Reader, products []product.Product)
 	}
 	sort.Ints(years)
 
-	fmt.Println("\nSelecione o ano (0 para voltar):")
+	fmt.Fprintln(s.out, i18n.T("\nSelecione o ano (0 para voltar):"))
 	for i, y := range years {
-		fmt.Printf("%d. %d\n", i+1, y)
+		fmt.Fprintf(s.out, "%d. %d\n", i+1, y)
 	}
-	fmt.Print("Ano: ")
-	yearStr, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Ano: "))
+	yearStr, _ := s.readLine()
 	yearStr = strings.TrimSpace(yearStr)
 
 	if yearStr == "0" {
@@ -59,7 +74,7 @@ func selectProductByYearMonth(reader *bufio.Reader, products []product.Product)
 
 	yearIdx, err := strconv.Atoi(yearStr)
 	if err != nil || yearIdx < 1 || yearIdx > len(years) {
-		fmt.Println("Ano inválido.")
+		fmt.Fprintln(s.out, i18n.T("Ano inválido."))
 		return -1, false
 	}
 	year := years[yearIdx-1]
@@ -71,12 +86,12 @@ func selectProductByYearMonth(reader *bufio.Reader, products []product.Product)
 	}
 	sort.Ints(months)
 
-	fmt.Println("\nSelecione o mês (0 para voltar):")
+	fmt.Fprintln(s.out, i18n.T("\nSelecione o mês (0 para voltar):"))
 	for i, m := range months {
-		fmt.Printf("%d. %s\n", i+1, monthNames[m-1])
+		fmt.Fprintf(s.out, "%d. %s\n", i+1, i18n.MonthName(m))
 	}
-	fmt.Print("Mês: ")
-	monthStr, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Mês: "))
+	monthStr, _ := s.readLine()
 	monthStr = strings.TrimSpace(monthStr)
 
 	if monthStr == "0" {
@@ -85,7 +100,7 @@ func selectProductByYearMonth(reader *bufio.Reader, products []product.Product)
 
 	monthIdx, err := strconv.Atoi(monthStr)
 	if err != nil || monthIdx < 1 || monthIdx > len(months) {
-		fmt.Println("Mês inválido.")
+		fmt.Fprintln(s.out, i18n.T("Mês inválido."))
 		return -1, false
 	}
 	month := months[monthIdx-1]
@@ -101,14 +116,14 @@ func selectProductByYearMonth(reader *bufio.Reader, products []product.Product)
 		}
 	}
 
-	fmt.Println("\nSelecione o produto (0 para voltar):")
+	fmt.Fprintln(s.out, i18n.T("\nSelecione o produto (0 para voltar):"))
 	for i, idx := range uniqueIndexes {
 		p := products[idx]
-		fmt.Printf("%d. %s | Total: R$%.2f | Parcelas: %d | Adicionado em: %s\n",
-			i+1, p.Name, p.TotalValue, p.Installments, p.CreatedAt.Format("02/01/2006"))
+		fmt.Fprintf(s.out, i18n.T("%d. %s | Total: %s | Parcelas: %d | Adicionado em: %s\n"),
+			i+1, p.Name, totalLabel(p.Currency, p.OriginalValue, p.TotalValue), p.Installments, i18n.FormatDate(p.CreatedAt))
 	}
-	fmt.Print("Produto: ")
-	prodStr, _ := reader.ReadString('\n')
+	fmt.Fprint(s.out, i18n.T("Produto: "))
+	prodStr, _ := s.readLine()
 	prodStr = strings.TrimSpace(prodStr)
 
 	if prodStr == "0" {
@@ -117,39 +132,14 @@ func selectProductByYearMonth(reader *bufio.Reader, products []product.Product)
 
 	prodIdx, err := strconv.Atoi(prodStr)
 	if err != nil || prodIdx < 1 || prodIdx > len(uniqueIndexes) {
-		fmt.Println("Produto inválido.")
+		fmt.Fprintln(s.out, i18n.T("Produto inválido."))
 		return -1, false
 	}
 	return uniqueIndexes[prodIdx-1], true
 }
 
-func readFloat(reader *bufio.Reader, prompt string) (float64, error) {
-	fmt.Print(prompt)
-	valueStr, _ := reader.ReadString('\n')
-	valueStr = strings.TrimSpace(valueStr)
-	valueStr = strings.ReplaceAll(valueStr, ",", ".")
-	return strconv.ParseFloat(valueStr, 64)
-}
-
-func isProductActiveInMonth(p product.Product, targetYear, targetMonth int) bool {
-	startDate := p.CreatedAt
-	endDate := startDate.AddDate(0, p.Installments-1, 0)
-
-	targetDate := time.Date(targetYear, time.Month(targetMonth), 1, 0, 0, 0, 0, time.UTC)
-	return !targetDate.Before(startDate) && !targetDate.After(endDate)
-}
-
-func getInstallmentNumber(p product.Product, targetYear, targetMonth int) int {
-	startDate := p.CreatedAt
-	yearDiff := targetYear - startDate.Year()
-	monthDiff := targetMonth - int(startDate.Month())
-	totalMonthDiff := yearDiff*12 + monthDiff + 1
-
-	if totalMonthDiff < 1 {
-		return 1
-	}
-	if totalMonthDiff > p.Installments {
-		return p.Installments
-	}
-	return totalMonthDiff
+func (s *session) readFloat(prompt string) (float64, error) {
+	fmt.Fprint(s.out, prompt)
+	valueStr, _ := s.readLine()
+	return money.Parse(valueStr)
 }
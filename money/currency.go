@@ -0,0 +1,110 @@
+package money
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+const BaseCurrency = "BRL"
+
+const DefaultIOF = 3.5
+
+var ErrInvalidCurrency = errors.New("moeda inválida: use um código de três letras, como USD")
+var ErrInvalidRate = errors.New("cotação inválida")
+var ErrUnknownRate = errors.New("moeda sem cotação na tabela")
+var ErrInvalidIOF = errors.New("IOF inválido: use um valor entre 0 e 100")
+
+var symbols = map[string]string{
+	"BRL": "R$",
+	"USD": "US$",
+	"EUR": "€",
+	"GBP": "£",
+}
+
+type Rates struct {
+	Rates     map[string]float64 `json:"rates"`
+	IOF       float64            `json:"iof"`
+	UpdatedAt time.Time          `json:"updated_at,omitempty"`
+}
+
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return BaseCurrency, nil
+	}
+	if len(code) != 3 {
+		return "", ErrInvalidCurrency
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", ErrInvalidCurrency
+		}
+	}
+	return code, nil
+}
+
+func Convert(amount, rate, iof float64) float64 {
+	return amount * rate * (1 + iof/100)
+}
+
+func NewRates() Rates {
+	return Rates{Rates: map[string]float64{}, IOF: DefaultIOF}
+}
+
+func (r Rates) Rate(currency string) (float64, bool) {
+	if currency == BaseCurrency {
+		return 1, true
+	}
+	rate, ok := r.Rates[currency]
+	return rate, ok
+}
+
+func (r *Rates) Set(currency string, rate float64, now time.Time) error {
+	currency, err := NormalizeCurrency(currency)
+	if err != nil {
+		return err
+	}
+	if currency == BaseCurrency {
+		return ErrInvalidCurrency
+	}
+	if rate <= 0 {
+		return ErrInvalidRate
+	}
+
+	if r.Rates == nil {
+		r.Rates = map[string]float64{}
+	}
+	r.Rates[currency] = rate
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Rates) Remove(currency string, now time.Time) bool {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if _, ok := r.Rates[currency]; !ok {
+		return false
+	}
+	delete(r.Rates, currency)
+	r.UpdatedAt = now
+	return true
+}
+
+func (r *Rates) SetIOF(iof float64, now time.Time) error {
+	if iof < 0 || iof > 100 {
+		return ErrInvalidIOF
+	}
+	r.IOF = iof
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r Rates) Currencies() []string {
+	currencies := make([]string, 0, len(r.Rates))
+	for currency := range r.Rates {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
@@ -0,0 +1,174 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+)
+
+var ErrInvalidAmount = errors.New("valor inválido")
+var ErrAmbiguousAmount = errors.New("valor ambíguo: use duas casas decimais ou o separador de milhar do idioma")
+
+type Style struct {
+	Decimal     string
+	Thousands   string
+	SymbolSpace bool
+}
+
+var styles = map[string]Style{
+	i18n.PtBR: {Decimal: ",", Thousands: ".", SymbolSpace: true},
+	i18n.EnUS: {Decimal: ".", Thousands: ",", SymbolSpace: false},
+	i18n.Es:   {Decimal: ",", Thousands: ".", SymbolSpace: true},
+}
+
+func CurrentStyle() Style {
+	return styles[i18n.Locale()]
+}
+
+func Format(value float64) string {
+	return FormatIn(value, BaseCurrency)
+}
+
+func FormatIn(value float64, currency string) string {
+	number := FormatNumber(value)
+
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+
+	symbol, ok := symbols[currency]
+	if !ok {
+		return sign + currency + " " + number
+	}
+	if CurrentStyle().SymbolSpace {
+		return sign + symbol + " " + number
+	}
+	return sign + symbol + number
+}
+
+func FormatNumber(value float64) string {
+	return formatDecimal(value, 2)
+}
+
+func FormatPercent(value float64, decimals int) string {
+	return formatDecimal(value, decimals)
+}
+
+func formatDecimal(value float64, decimals int) string {
+	style := CurrentStyle()
+
+	scale := int64(math.Pow10(decimals))
+	units := int64(math.Round(math.Abs(value) * float64(scale)))
+	whole := strconv.FormatInt(units/scale, 10)
+
+	var b strings.Builder
+	if value < 0 && units > 0 {
+		b.WriteByte('-')
+	}
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(style.Thousands)
+		}
+		b.WriteRune(digit)
+	}
+	if decimals > 0 {
+		b.WriteString(style.Decimal)
+		fmt.Fprintf(&b, "%0*d", decimals, units%scale)
+	}
+	return b.String()
+}
+
+func Parse(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	s = strings.ToUpper(strings.TrimSpace(s))
+	for _, symbol := range symbols {
+		if strings.HasPrefix(s, strings.ToUpper(symbol)) {
+			s = s[len(symbol):]
+			break
+		}
+	}
+	s = strings.TrimPrefix(s, "$")
+	s = strings.ReplaceAll(s, " ", "")
+	if s == "" {
+		return 0, ErrInvalidAmount
+	}
+	if ambiguousGroup(s) {
+		return 0, ErrAmbiguousAmount
+	}
+
+	decimal := decimalSeparator(s)
+	var normalized strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			normalized.WriteRune(r)
+		case decimal >= 0 && i == decimal:
+			normalized.WriteByte('.')
+		case r == '.' || r == ',':
+			if !validGroup(s, i) {
+				return 0, ErrInvalidAmount
+			}
+		default:
+			return 0, ErrInvalidAmount
+		}
+	}
+
+	value, err := strconv.ParseFloat(normalized.String(), 64)
+	if err != nil {
+		return 0, ErrInvalidAmount
+	}
+	if negative {
+		value = -value
+	}
+	return value, nil
+}
+
+func decimalSeparator(s string) int {
+	lastDot := strings.LastIndex(s, ".")
+	lastComma := strings.LastIndex(s, ",")
+
+	if lastDot >= 0 && lastComma >= 0 {
+		return max(lastDot, lastComma)
+	}
+
+	last := max(lastDot, lastComma)
+	if last < 0 {
+		return -1
+	}
+
+	separator := s[last : last+1]
+	if strings.Count(s, separator) > 1 {
+		return -1
+	}
+	if len(s)-last-1 == 3 && separator == CurrentStyle().Thousands {
+		return -1
+	}
+	return last
+}
+
+func ambiguousGroup(s string) bool {
+	last := strings.LastIndexAny(s, ".,")
+	if last < 0 || strings.IndexAny(s, ".,") != last || len(s)-last-1 != 3 {
+		return false
+	}
+	if s[last:last+1] == CurrentStyle().Thousands {
+		return false
+	}
+	whole := s[:last]
+	return len(whole) >= 1 && len(whole) <= 3 && whole[0] != '0'
+}
+
+func validGroup(s string, i int) bool {
+	end := i + 1
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	return i > 0 && end-i-1 == 3
+}
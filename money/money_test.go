@@ -0,0 +1,128 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+)
+
+func useLocale(t *testing.T, locale string) {
+	t.Helper()
+	previous := i18n.Locale()
+	if err := i18n.SetLocale(locale); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { i18n.SetLocale(previous) })
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input  string
+		locale string
+		want   float64
+		err    error
+	}{
+		{input: "1234", locale: i18n.PtBR, want: 1234},
+		{input: "1234,56", locale: i18n.PtBR, want: 1234.56},
+		{input: "1234.56", locale: i18n.PtBR, want: 1234.56},
+		{input: "1.234,56", locale: i18n.PtBR, want: 1234.56},
+		{input: "1,234.56", locale: i18n.PtBR, want: 1234.56},
+		{input: "1.234", locale: i18n.PtBR, want: 1234},
+		{input: "1.234.567", locale: i18n.PtBR, want: 1234567},
+		{input: "1,234", locale: i18n.PtBR, err: ErrAmbiguousAmount},
+		{input: "0,125", locale: i18n.PtBR, want: 0.125},
+		{input: "1234,567", locale: i18n.PtBR, want: 1234.567},
+		{input: "10,5", locale: i18n.PtBR, want: 10.5},
+		{input: "R$ 1.234,56", locale: i18n.PtBR, want: 1234.56},
+		{input: "r$10", locale: i18n.PtBR, want: 10},
+		{input: "-R$ 10,00", locale: i18n.PtBR, want: -10},
+		{input: "1,234", locale: i18n.EnUS, want: 1234},
+		{input: "1.234", locale: i18n.EnUS, err: ErrAmbiguousAmount},
+		{input: "1,234.56", locale: i18n.EnUS, want: 1234.56},
+		{input: "1234,56", locale: i18n.EnUS, want: 1234.56},
+		{input: "0.125", locale: i18n.EnUS, want: 0.125},
+		{input: "US$ 10", locale: i18n.EnUS, want: 10},
+		{input: "$10", locale: i18n.EnUS, want: 10},
+		{input: "$1,234.50", locale: i18n.EnUS, want: 1234.5},
+		{input: "€ 9,99", locale: i18n.Es, want: 9.99},
+		{input: "1.234", locale: i18n.Es, want: 1234},
+		{input: "1,234", locale: i18n.Es, err: ErrAmbiguousAmount},
+		{input: "", locale: i18n.PtBR, err: ErrInvalidAmount},
+		{input: "R$", locale: i18n.PtBR, err: ErrInvalidAmount},
+		{input: "12,34,56", locale: i18n.PtBR, err: ErrInvalidAmount},
+		{input: "1.23,45", locale: i18n.PtBR, err: ErrInvalidAmount},
+		{input: ",50", locale: i18n.PtBR, want: 0.5},
+		{input: "dez", locale: i18n.PtBR, err: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.input, func(t *testing.T) {
+			useLocale(t, tt.locale)
+			got, err := Parse(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Parse(%q) erro = %v, esperado %v", tt.input, err, tt.err)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("Parse(%q) = %v, esperado %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		locale   string
+		value    float64
+		currency string
+		want     string
+	}{
+		{locale: i18n.PtBR, value: 1234.5, currency: BaseCurrency, want: "R$ 1.234,50"},
+		{locale: i18n.PtBR, value: -0.004, currency: BaseCurrency, want: "R$ 0,00"},
+		{locale: i18n.PtBR, value: -1234567.891, currency: BaseCurrency, want: "-R$ 1.234.567,89"},
+		{locale: i18n.EnUS, value: 1234.5, currency: BaseCurrency, want: "R$1,234.50"},
+		{locale: i18n.EnUS, value: 10, currency: "USD", want: "US$10.00"},
+		{locale: i18n.Es, value: 10, currency: "EUR", want: "€ 10,00"},
+		{locale: i18n.PtBR, value: 10, currency: "JPY", want: "JPY 10,00"},
+	}
+
+	for _, tt := range tests {
+		useLocale(t, tt.locale)
+		if got := FormatIn(tt.value, tt.currency); got != tt.want {
+			t.Errorf("%s: FormatIn(%v, %s) = %q, esperado %q", tt.locale, tt.value, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	tests := []struct {
+		locale   string
+		value    float64
+		decimals int
+		want     string
+	}{
+		{locale: i18n.PtBR, value: 12.345, decimals: 2, want: "12,35"},
+		{locale: i18n.EnUS, value: 12.345, decimals: 2, want: "12.35"},
+		{locale: i18n.PtBR, value: 62.5, decimals: 0, want: "63"},
+		{locale: i18n.Es, value: 7.25, decimals: 1, want: "7,3"},
+		{locale: i18n.PtBR, value: 0, decimals: 2, want: "0,00"},
+		{locale: i18n.EnUS, value: -3.5, decimals: 1, want: "-3.5"},
+		{locale: i18n.PtBR, value: 1500, decimals: 0, want: "1.500"},
+	}
+
+	for _, tt := range tests {
+		useLocale(t, tt.locale)
+		if got := FormatPercent(tt.value, tt.decimals); got != tt.want {
+			t.Errorf("%s: FormatPercent(%v, %d) = %q, esperado %q", tt.locale, tt.value, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	if got := Convert(100, 5, DefaultIOF); got != 517.5 {
+		t.Errorf("Convert = %v, esperado 517.5", got)
+	}
+	if got := Convert(100, 5, 0); got != 500 {
+		t.Errorf("Convert sem IOF = %v, esperado 500", got)
+	}
+}
@@ -0,0 +1,22 @@
+//go:build !unix
+
+package notify
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+	"strconv"
+)
+
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS != "windows" {
+		return exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	cmd := exec.CommandContext(ctx, "cmd", "/c", command)
+	cmd.Cancel = func() error {
+		return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+	}
+	return cmd
+}
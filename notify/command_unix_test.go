@@ -0,0 +1,57 @@
+//go:build unix
+
+package notify
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRunCommandTimeoutKillsChildren(t *testing.T) {
+	previous := hookTimeout
+	hookTimeout = 200 * time.Millisecond
+	defer func() { hookTimeout = previous }()
+
+	pidFile := filepath.Join(t.TempDir(), "pid")
+	command := "sleep 30 & echo $! > " + strconv.Quote(pidFile) + "; wait"
+	if err := runCommand(command, Message{}); err == nil {
+		t.Fatal("esperava erro de tempo esgotado")
+	}
+
+	data, err := os.ReadFile(pidFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pgid, err := syscall.Getpgid(pid)
+	if errors.Is(err, syscall.ESRCH) {
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pgid == syscall.Getpgrp() {
+		t.Fatal("o comando deveria rodar em um grupo de processos próprio")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		err := syscall.Kill(-pgid, 0)
+		if errors.Is(err, syscall.ESRCH) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("o grupo de processos %d continua vivo após o tempo esgotado: %v", pgid, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
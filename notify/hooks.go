@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var hookTimeout = 30 * time.Second
+
+var ErrInvalidSMTP = errors.New("configuração de SMTP inválida")
+
+type SMTPConfig struct {
+	Host     string   `json:"host"`
+	Port     int      `json:"port,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+}
+
+func runCommand(command string, msg Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+
+	cmd := shellCommand(ctx, command)
+	cmd.Env = append(os.Environ(), "SSC_SUBJECT="+msg.Subject, "SSC_MESSAGE="+msg.Text, "SSC_PROFILE="+msg.Profile)
+	cmd.Stdin = strings.NewReader(msg.Text)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.WaitDelay = time.Second
+
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("tempo esgotado após %s", hookTimeout)
+	}
+	return err
+}
+
+func postWebhook(url string, msg Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	client := http.Client{Timeout: hookTimeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("resposta %s", resp.Status)
+	}
+	return nil
+}
+
+func sendMail(cfg SMTPConfig, msg Message, now time.Time) error {
+	if cfg.Host == "" || cfg.From == "" || len(cfg.To) == 0 {
+		return fmt.Errorf("%w: host, from e to são obrigatórios", ErrInvalidSMTP)
+	}
+	port := cfg.Port
+	if port == 0 {
+		port = 25
+	}
+	password := cfg.Password
+	if password == "" {
+		password = os.Getenv("SSC_SMTP_PASSWORD")
+	}
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, password, cfg.Host)
+	}
+
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(port))
+	return smtp.SendMail(addr, auth, cfg.From, cfg.To, mailBody(cfg, msg, now))
+}
+
+func mailBody(cfg SMTPConfig, msg Message, now time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", cfg.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(cfg.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	writer := quotedprintable.NewWriter(&buf)
+	writer.Write([]byte(strings.ReplaceAll(msg.Text, "\n", "\r\n")))
+	writer.Close()
+	return buf.Bytes()
+}
@@ -0,0 +1,136 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/alert"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+const DefaultDays = 3
+
+var ErrNoHooks = errors.New("nenhum meio de notificação configurado")
+
+type Config struct {
+	Days    int         `json:"days,omitempty"`
+	Command string      `json:"command,omitempty"`
+	Webhook string      `json:"webhook,omitempty"`
+	SMTP    *SMTPConfig `json:"smtp,omitempty"`
+}
+
+type Due struct {
+	Product      string    `json:"product"`
+	Number       int       `json:"number"`
+	Installments int       `json:"installments"`
+	Due          time.Time `json:"due"`
+	Amount       float64   `json:"amount"`
+	Card         string    `json:"card,omitempty"`
+}
+
+type Message struct {
+	Profile string   `json:"profile"`
+	Subject string   `json:"subject"`
+	Text    string   `json:"text"`
+	Due     []Due    `json:"installments"`
+	Alerts  []string `json:"alerts"`
+}
+
+func (c Config) HasHooks() bool {
+	return c.Command != "" || c.Webhook != "" || c.SMTP != nil
+}
+
+func Build(profile string, list product.ProductList, rules []alert.Rule, now time.Time, days int) Message {
+	msg := Message{Profile: profile, Due: []Due{}, Alerts: []string{}}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	end := today.AddDate(0, 0, days+1)
+	var total float64
+	for _, installment := range list.Schedule() {
+		p := installment.Product
+		if installment.Due.Before(today) || !installment.Due.Before(end) || p.IsPaid(installment.Number) {
+			continue
+		}
+		msg.Due = append(msg.Due, Due{
+			Product:      p.Name,
+			Number:       installment.Number,
+			Installments: p.Installments,
+			Due:          installment.Due,
+			Amount:       installment.Amount,
+			Card:         p.Card,
+		})
+		total += installment.Amount
+	}
+
+	for _, a := range alert.Evaluate(list, rules, now) {
+		msg.Alerts = append(msg.Alerts, a.Message)
+	}
+
+	var text strings.Builder
+	if len(msg.Due) > 0 {
+		text.WriteString(i18n.Sprintf("Parcelas a vencer nos próximos %d dia(s):\n", days))
+		for _, due := range msg.Due {
+			card := ""
+			if due.Card != "" {
+				card = " [" + due.Card + "]"
+			}
+			fmt.Fprintf(&text, "- %s: %s (%d/%d) %s%s\n", i18n.FormatDate(due.Due), due.Product,
+				due.Number, due.Installments, money.Format(due.Amount), card)
+		}
+		text.WriteString(i18n.Sprintf("Total: %s\n", money.Format(total)))
+	}
+	if len(msg.Alerts) > 0 {
+		if text.Len() > 0 {
+			text.WriteString("\n")
+		}
+		text.WriteString(i18n.T("Alertas:\n"))
+		for _, message := range msg.Alerts {
+			fmt.Fprintf(&text, "- %s\n", message)
+		}
+	}
+	msg.Text = text.String()
+
+	var summary string
+	switch {
+	case len(msg.Due) > 0 && len(msg.Alerts) > 0:
+		summary = i18n.Sprintf("%d parcela(s) a vencer e %d alerta(s)", len(msg.Due), len(msg.Alerts))
+	case len(msg.Due) > 0:
+		summary = i18n.Sprintf("%d parcela(s) a vencer", len(msg.Due))
+	default:
+		summary = i18n.Sprintf("%d alerta(s)", len(msg.Alerts))
+	}
+	msg.Subject = i18n.Sprintf("Gestor de Gastos (%s): %s", profile, summary)
+	return msg
+}
+
+func (m Message) Empty() bool {
+	return len(m.Due) == 0 && len(m.Alerts) == 0
+}
+
+func Deliver(cfg Config, msg Message, now time.Time) error {
+	if !cfg.HasHooks() {
+		return ErrNoHooks
+	}
+
+	var errs []error
+	if cfg.Command != "" {
+		if err := runCommand(cfg.Command, msg); err != nil {
+			errs = append(errs, fmt.Errorf("comando: %w", err))
+		}
+	}
+	if cfg.Webhook != "" {
+		if err := postWebhook(cfg.Webhook, msg); err != nil {
+			errs = append(errs, fmt.Errorf("webhook: %w", err))
+		}
+	}
+	if cfg.SMTP != nil {
+		if err := sendMail(*cfg.SMTP, msg, now); err != nil {
+			errs = append(errs, fmt.Errorf("e-mail: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
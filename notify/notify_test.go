@@ -0,0 +1,153 @@
+package notify
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+var now = time.Date(2026, time.April, 9, 8, 0, 0, 0, time.Local)
+
+func testMessage(t *testing.T) Message {
+	t.Helper()
+	p, err := product.New("Notebook", 300, 3, time.Date(2026, time.March, 10, 12, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.ID = 1
+	return Build("principal", product.ProductList{Products: []product.Product{p}}, nil, now, 3)
+}
+
+func setLocale(t *testing.T, locale string) {
+	t.Helper()
+	if err := i18n.SetLocale(locale); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { i18n.SetLocale(i18n.PtBR) })
+}
+
+func TestBuildTranslated(t *testing.T) {
+	setLocale(t, i18n.EnUS)
+	msg := testMessage(t)
+
+	if want := "Spending Checker (principal): 1 installment(s) due"; msg.Subject != want {
+		t.Errorf("assunto = %q, esperado %q", msg.Subject, want)
+	}
+	if want := "- 04/10/2026: Notebook (2/3) R$100.00\n"; !strings.Contains(msg.Text, want) {
+		t.Errorf("texto sem %q:\n%s", want, msg.Text)
+	}
+}
+
+func TestDeliverWebhook(t *testing.T) {
+	setLocale(t, i18n.PtBR)
+	received := make(chan Message, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg Message
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		received <- msg
+	}))
+	defer server.Close()
+
+	msg := testMessage(t)
+	if err := Deliver(Config{Webhook: server.URL}, msg, now); err != nil {
+		t.Fatal(err)
+	}
+	got := <-received
+	if got.Subject != msg.Subject || len(got.Due) != 1 || got.Due[0].Number != 2 {
+		t.Errorf("webhook recebeu %+v", got)
+	}
+}
+
+func TestDeliverWebhookError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	if err := Deliver(Config{Webhook: server.URL}, testMessage(t), now); err == nil {
+		t.Error("esperava erro com resposta 500")
+	}
+}
+
+func TestDeliverMail(t *testing.T) {
+	setLocale(t, i18n.PtBR)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go serveSMTP(listener, received)
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	cfg := SMTPConfig{Host: "127.0.0.1", Port: port, From: "gastos@example.com", To: []string{"eu@example.com"}}
+	msg := testMessage(t)
+	if err := Deliver(Config{SMTP: &cfg}, msg, now); err != nil {
+		t.Fatal(err)
+	}
+
+	data := <-received
+	for _, want := range []string{
+		"To: eu@example.com\r\n",
+		"Date: " + now.Format(time.RFC1123Z) + "\r\n",
+		"Notebook (2/3)",
+	} {
+		if !strings.Contains(data, want) {
+			t.Errorf("e-mail sem %q:\n%s", want, data)
+		}
+	}
+}
+
+func serveSMTP(listener net.Listener, received chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		switch command := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case command == "DATA":
+			reply("354 fim com .")
+			var data strings.Builder
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			received <- data.String()
+			reply("250 ok")
+		case command == "QUIT":
+			reply("221 tchau")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package ofx
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var ErrNoTransactions = errors.New("nenhuma transação encontrada no arquivo OFX")
+
+var tagPattern = regexp.MustCompile(`<(/?)([A-Za-z0-9.]+)>([^<]*)`)
+
+var entities = strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">", "&quot;", `"`, "&apos;", "'", "&nbsp;", " ")
+
+type Transaction struct {
+	ID     string
+	Type   string
+	Date   time.Time
+	Amount float64
+	Memo   string
+}
+
+type Statement struct {
+	Account      string
+	Transactions []Transaction
+}
+
+func Parse(r io.Reader) (Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Statement{}, err
+	}
+	text := toUTF8(data)
+
+	var stmt Statement
+	var current *Transaction
+	var name string
+	for _, match := range tagPattern.FindAllStringSubmatch(text, -1) {
+		closing, tag := match[1] == "/", strings.ToUpper(match[2])
+		value := strings.TrimSpace(entities.Replace(match[3]))
+
+		if tag == "STMTTRN" {
+			if closing && current != nil {
+				if current.Memo == "" {
+					current.Memo = name
+				}
+				stmt.Transactions = append(stmt.Transactions, *current)
+				current = nil
+			} else if !closing {
+				current = &Transaction{}
+				name = ""
+			}
+			continue
+		}
+		if closing || value == "" {
+			continue
+		}
+
+		if tag == "ACCTID" && stmt.Account == "" {
+			stmt.Account = value
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		switch tag {
+		case "FITID":
+			current.ID = value
+		case "TRNTYPE":
+			current.Type = strings.ToUpper(value)
+		case "DTPOSTED":
+			if date, ok := parseDate(value); ok {
+				current.Date = date
+			}
+		case "TRNAMT":
+			if amount, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", "."), 64); err == nil {
+				current.Amount = amount
+			}
+		case "MEMO":
+			current.Memo = collapseSpaces(value)
+		case "NAME":
+			name = collapseSpaces(value)
+		}
+	}
+
+	if len(stmt.Transactions) == 0 {
+		return stmt, ErrNoTransactions
+	}
+	return stmt, nil
+}
+
+func parseDate(value string) (time.Time, bool) {
+	if len(value) < 8 {
+		return time.Time{}, false
+	}
+	date, err := time.ParseInLocation("20060102", value[:8], time.Local)
+	return date, err == nil
+}
+
+func toUTF8(data []byte) string {
+	if utf8.Valid(data) {
+		return string(data)
+	}
+
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,194 @@
+package ofx
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+type Status string
+
+const (
+	StatusMatched   Status = "matched"
+	StatusNew       Status = "new"
+	StatusAmbiguous Status = "ambiguous"
+	StatusUnmatched Status = "unmatched"
+)
+
+const maxInstallments = 48
+
+var installmentPattern = regexp.MustCompile(`(?i)^(.*?)[\s\-*]*(PARC(?:ELA)?\.?\s*)?(\d{1,2})\s*(/|DE)\s*(\d{1,2})$`)
+
+type Entry struct {
+	Transaction  Transaction
+	Status       Status
+	Name         string
+	Number       int
+	Installments int
+	Marked       bool
+	Product      product.Product
+	AlreadyPaid  bool
+}
+
+func ParseInstallment(memo string) (string, int, int, bool) {
+	name, number, total, _, ok := parseInstallment(memo)
+	return name, number, total, ok
+}
+
+func parseInstallment(memo string) (string, int, int, bool, bool) {
+	match := installmentPattern.FindStringSubmatch(strings.TrimSpace(memo))
+	if match == nil {
+		return "", 0, 0, false, false
+	}
+
+	name := strings.TrimSpace(match[1])
+	number, _ := strconv.Atoi(match[3])
+	total, _ := strconv.Atoi(match[5])
+	if name == "" || total < 2 || total > maxInstallments || number < 1 || number > total {
+		return "", 0, 0, false, false
+	}
+	marked := match[2] != "" || match[4] != "/"
+	return name, number, total, marked, true
+}
+
+func Reconcile(products []product.Product, stmt Statement, card string) []Entry {
+	candidates := append([]product.Product(nil), products...)
+	claimed := make(map[[2]int]bool)
+	nextNew := -1
+
+	entries := make([]Entry, 0, len(stmt.Transactions))
+	for _, t := range stmt.Transactions {
+		entry := Entry{Transaction: t, Status: StatusUnmatched, Name: t.Memo, Number: 1, Installments: 1}
+		if name, number, total, marked, ok := parseInstallment(t.Memo); ok {
+			entry.Name, entry.Number, entry.Installments, entry.Marked = name, number, total, marked
+		}
+
+		if t.Amount >= 0 {
+			entries = append(entries, entry)
+			continue
+		}
+		value := -t.Amount
+
+		best, bestScore, ambiguous := -1, 0, -1
+		for i, p := range candidates {
+			if claimed[[2]int{p.ID, entry.Number}] {
+				continue
+			}
+			if score := matchScore(p, entry, value, card); score > bestScore {
+				best, bestScore = i, score
+			} else if score == 0 && ambiguous < 0 && entry.Marked && sameInstallment(p, entry, value, card) && dueNear(p, entry) {
+				ambiguous = i
+			}
+		}
+
+		switch {
+		case best >= 0:
+			p := candidates[best]
+			claimed[[2]int{p.ID, entry.Number}] = true
+			entry.Status = StatusMatched
+			entry.Product = p
+			entry.AlreadyPaid = p.IsPaid(entry.Number)
+		case ambiguous >= 0:
+			entry.Status = StatusAmbiguous
+			entry.Product = candidates[ambiguous]
+		case entry.Marked:
+			p, err := newProduct(entry, value, card)
+			if err != nil {
+				break
+			}
+			p.ID = nextNew
+			nextNew--
+			claimed[[2]int{p.ID, entry.Number}] = true
+			candidates = append(candidates, p)
+			entry.Status = StatusNew
+			entry.Product = p
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func Apply(list *product.ProductList, entries []Entry, now time.Time) error {
+	created := make(map[int]int)
+	for _, entry := range entries {
+		switch entry.Status {
+		case StatusNew:
+			p := entry.Product
+			p.ID = 0
+			added, err := list.Add(p, now)
+			if err != nil {
+				return err
+			}
+			created[entry.Product.ID] = added.ID
+			if err := list.MarkPaid(added.ID, entry.Number, now); err != nil {
+				return err
+			}
+		case StatusMatched:
+			id := entry.Product.ID
+			if newID, ok := created[id]; ok {
+				id = newID
+			}
+			idx, ok := list.FindByID(id)
+			if !ok {
+				return product.ErrProductNotFound
+			}
+			if list.Products[idx].IsPaid(entry.Number) {
+				continue
+			}
+			if err := list.MarkPaid(id, entry.Number, now); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func matchScore(p product.Product, entry Entry, value float64, card string) int {
+	if !sameInstallment(p, entry, value, card) || !p.NameMatches(entry.Name) {
+		return 0
+	}
+
+	score := 1
+	if dueNear(p, entry) {
+		score++
+	}
+	return score
+}
+
+func sameInstallment(p product.Product, entry Entry, value float64, card string) bool {
+	if p.Installments != entry.Installments || !closeTo(p.Parcel, value) {
+		return false
+	}
+	return card == "" || p.Card == "" || strings.EqualFold(p.Card, card)
+}
+
+func dueNear(p product.Product, entry Entry) bool {
+	year, month := p.InstallmentMonth(entry.Number)
+	due := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
+	posted := time.Date(entry.Transaction.Date.Year(), entry.Transaction.Date.Month(), 1, 0, 0, 0, 0, time.Local)
+	return monthsBetween(due, posted) <= 1
+}
+
+func newProduct(entry Entry, value float64, card string) (product.Product, error) {
+	createdAt := entry.Transaction.Date.AddDate(0, -(entry.Number - 1), 0)
+	total := math.Round(value*float64(entry.Installments)*100) / 100
+	p, err := product.New(entry.Name, total, entry.Installments, createdAt)
+	p.Card = card
+	return p, err
+}
+
+func closeTo(parcel, value float64) bool {
+	return math.Abs(parcel-value) <= math.Max(0.05, value*0.01)
+}
+
+func monthsBetween(a, b time.Time) int {
+	diff := (a.Year()-b.Year())*12 + int(a.Month()) - int(b.Month())
+	if diff < 0 {
+		return -diff
+	}
+	return diff
+}
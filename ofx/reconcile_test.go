@@ -0,0 +1,202 @@
+package ofx
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 12, 0, 0, 0, time.Local)
+}
+
+func testProducts(t *testing.T) []product.Product {
+	t.Helper()
+	notebook, err := product.New("Notebook Dell", 3000, 10, date(2026, time.January, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	notebook.ID = 1
+	notebook.Card = "Nubank"
+
+	fone, err := product.New("Fone JBL", 300, 3, date(2026, time.February, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fone.ID = 2
+	fone.Paid = []int{2}
+
+	chaveiro, err := product.New("Chaveiro", 12, 3, date(2026, time.February, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chaveiro.ID = 3
+	return []product.Product{notebook, fone, chaveiro}
+}
+
+func TestParseInstallment(t *testing.T) {
+	tests := []struct {
+		memo   string
+		name   string
+		number int
+		total  int
+		marked bool
+		ok     bool
+	}{
+		{memo: "LOJA X PARC 03/10", name: "LOJA X", number: 3, total: 10, marked: true, ok: true},
+		{memo: "LOJA X PARC. 3/10", name: "LOJA X", number: 3, total: 10, marked: true, ok: true},
+		{memo: "LOJA X - Parcela 3/10", name: "LOJA X", number: 3, total: 10, marked: true, ok: true},
+		{memo: "LOJA X PARCELA 3 DE 10", name: "LOJA X", number: 3, total: 10, marked: true, ok: true},
+		{memo: "LOJA X 3 de 10", name: "LOJA X", number: 3, total: 10, marked: true, ok: true},
+		{memo: "LOJA X 03/10", name: "LOJA X", number: 3, total: 10, ok: true},
+		{memo: "UBER 12/25", name: "UBER", number: 12, total: 25, ok: true},
+		{memo: "LOJA X PARC 03/60", ok: false},
+		{memo: "LOJA X 11/10", ok: false},
+		{memo: "LOJA X 00/10", ok: false},
+		{memo: "LOJA X 01/01", ok: false},
+		{memo: "PARC 03/10", ok: false},
+		{memo: "LOJA X", ok: false},
+	}
+
+	for _, tt := range tests {
+		name, number, total, marked, ok := parseInstallment(tt.memo)
+		if ok != tt.ok || name != tt.name || number != tt.number || total != tt.total || marked != tt.marked {
+			t.Errorf("parseInstallment(%q) = %q, %d, %d, %v, %v; esperado %q, %d, %d, %v, %v",
+				tt.memo, name, number, total, marked, ok, tt.name, tt.number, tt.total, tt.marked, tt.ok)
+		}
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name        string
+		memo        string
+		amount      float64
+		date        time.Time
+		card        string
+		status      Status
+		productID   int
+		number      int
+		alreadyPaid bool
+	}{
+		{name: "marcada com PARC", memo: "NOTEBOOK DELL PARC 03/10", amount: -300, date: date(2026, time.March, 12), status: StatusMatched, productID: 1, number: 3},
+		{name: "forma simples com produto existente", memo: "NOTEBOOK DELL 03/10", amount: -300, date: date(2026, time.March, 12), status: StatusMatched, productID: 1, number: 3},
+		{name: "dentro de 1%", memo: "NOTEBOOK DELL PARC 03/10", amount: -302.9, date: date(2026, time.March, 12), status: StatusMatched, productID: 1, number: 3},
+		{name: "fora de 1%", memo: "NOTEBOOK DELL 03/10", amount: -303.5, date: date(2026, time.March, 12), status: StatusUnmatched},
+		{name: "dentro de R$ 0,05", memo: "CHAVEIRO PARC 01/03", amount: -4.05, date: date(2026, time.February, 12), status: StatusMatched, productID: 3, number: 1},
+		{name: "fora de R$ 0,05", memo: "CHAVEIRO 01/03", amount: -4.07, date: date(2026, time.February, 12), status: StatusUnmatched},
+		{name: "nome diferente", memo: "MERCADO PARC 03/10", amount: -300, date: date(2026, time.March, 12), status: StatusAmbiguous, productID: 1, number: 3},
+		{name: "nome diferente sem marcador", memo: "UBER 03/10", amount: -300, date: date(2026, time.March, 12), status: StatusUnmatched},
+		{name: "mesmo cartão", memo: "NOTEBOOK DELL PARC 03/10", amount: -300, date: date(2026, time.March, 12), card: "nubank", status: StatusMatched, productID: 1, number: 3},
+		{name: "outro cartão", memo: "NOTEBOOK DELL PARC 03/10", amount: -300, date: date(2026, time.March, 12), card: "Inter", status: StatusNew, number: 3},
+		{name: "parcela já paga", memo: "FONE JBL PARC 02/03", amount: -100, date: date(2026, time.March, 12), status: StatusMatched, productID: 2, number: 2, alreadyPaid: true},
+		{name: "parcelamento desconhecido", memo: "LOJA NOVA PARCELA 2 DE 4", amount: -50, date: date(2026, time.March, 12), status: StatusNew, number: 2},
+		{name: "desconhecido sem marcador", memo: "LOJA NOVA 02/04", amount: -50, date: date(2026, time.March, 12), status: StatusUnmatched},
+		{name: "acima de 48 parcelas", memo: "NOTEBOOK DELL PARC 03/60", amount: -300, date: date(2026, time.March, 12), status: StatusUnmatched},
+		{name: "estorno", memo: "NOTEBOOK DELL PARC 03/10", amount: 300, date: date(2026, time.March, 12), status: StatusUnmatched},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt := Statement{Transactions: []Transaction{{Memo: tt.memo, Amount: tt.amount, Date: tt.date}}}
+			entries := Reconcile(testProducts(t), stmt, tt.card)
+			if len(entries) != 1 {
+				t.Fatalf("%d entradas, esperado 1", len(entries))
+			}
+
+			entry := entries[0]
+			if entry.Status != tt.status {
+				t.Fatalf("status = %s, esperado %s", entry.Status, tt.status)
+			}
+			if tt.status == StatusUnmatched {
+				return
+			}
+			if tt.productID != 0 && entry.Product.ID != tt.productID {
+				t.Errorf("produto = %d, esperado %d", entry.Product.ID, tt.productID)
+			}
+			if entry.Number != tt.number || entry.AlreadyPaid != tt.alreadyPaid {
+				t.Errorf("parcela %d (já paga %v), esperado %d (%v)", entry.Number, entry.AlreadyPaid, tt.number, tt.alreadyPaid)
+			}
+		})
+	}
+}
+
+func TestReconcileNewProduct(t *testing.T) {
+	stmt := Statement{Transactions: []Transaction{
+		{Memo: "LOJA NOVA PARC 02/04", Amount: -50, Date: date(2026, time.March, 12)},
+		{Memo: "LOJA NOVA PARC 02/04", Amount: -50, Date: date(2026, time.March, 12)},
+	}}
+	entries := Reconcile(nil, stmt, "Inter")
+
+	p := entries[0].Product
+	if entries[0].Status != StatusNew || p.Name != "LOJA NOVA" || p.TotalValue != 200 || p.Card != "Inter" {
+		t.Errorf("produto novo inesperado: %+v", entries[0])
+	}
+	if year, month := p.InstallmentMonth(2); year != 2026 || month != 3 {
+		t.Errorf("parcela 2 em %d-%02d, esperado 2026-03", year, month)
+	}
+	if entries[1].Status != StatusNew || entries[1].Product.ID == p.ID {
+		t.Errorf("segunda compra igual deveria criar outro produto: %+v", entries[1])
+	}
+}
+
+func TestApply(t *testing.T) {
+	list := product.ProductList{Products: testProducts(t), NextID: 4}
+	stmt := Statement{Transactions: []Transaction{
+		{Memo: "NOTEBOOK DELL PARC 03/10", Amount: -300, Date: date(2026, time.March, 12)},
+		{Memo: "FONE JBL PARC 02/03", Amount: -100, Date: date(2026, time.March, 12)},
+		{Memo: "LOJA NOVA PARC 02/04", Amount: -50, Date: date(2026, time.March, 12)},
+		{Memo: "MERCADO PARC 01/03", Amount: -100, Date: date(2026, time.February, 12)},
+	}}
+	entries := Reconcile(list.Products, stmt, "")
+
+	if err := Apply(&list, entries, date(2026, time.March, 20)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !list.Products[0].IsPaid(3) {
+		t.Error("parcela 3 do notebook deveria estar paga")
+	}
+	if paid := list.Products[1].Paid; len(paid) != 1 || paid[0] != 2 {
+		t.Errorf("parcelas pagas do fone = %v, esperado [2]", paid)
+	}
+	if len(list.Products) != 4 {
+		t.Fatalf("%d produtos, esperado 4", len(list.Products))
+	}
+	if created := list.Products[3]; created.Name != "LOJA NOVA" || created.ID <= 0 || !created.IsPaid(2) {
+		t.Errorf("produto criado inesperado: %+v", created)
+	}
+	if fone := list.Products[1]; fone.IsPaid(1) {
+		t.Error("a compra ambígua não deveria marcar parcela")
+	}
+}
+
+func TestParse(t *testing.T) {
+	data := `OFXHEADER:100
+<OFX><BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20260312000000[-3:BRT]<TRNAMT>-300,00<FITID>1<MEMO>NOTEBOOK  DELL PARC 03/10
+</STMTTRN>
+<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20260315<TRNAMT>50.00<FITID>2<NAME>ESTORNO &amp; AJUSTE</STMTTRN>
+</BANKTRANLIST></OFX>`
+
+	stmt, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stmt.Transactions) != 2 {
+		t.Fatalf("%d transações, esperado 2", len(stmt.Transactions))
+	}
+	first, second := stmt.Transactions[0], stmt.Transactions[1]
+	if first.Memo != "NOTEBOOK DELL PARC 03/10" || first.Amount != -300 || !first.Date.Equal(time.Date(2026, time.March, 12, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("primeira transação inesperada: %+v", first)
+	}
+	if second.Memo != "ESTORNO & AJUSTE" || second.Amount != 50 || second.Type != "CREDIT" {
+		t.Errorf("segunda transação inesperada: %+v", second)
+	}
+
+	if _, err := Parse(strings.NewReader("<OFX></OFX>")); err != ErrNoTransactions {
+		t.Errorf("erro = %v, esperado %v", err, ErrNoTransactions)
+	}
+}
@@ -0,0 +1,60 @@
+package product
+
+import "github.com/pedrorcruzz/smart-spending-checker/money"
+
+func (p Product) IsForeign() bool {
+	return p.Currency != "" && p.Currency != money.BaseCurrency
+}
+
+func (p Product) Amount() float64 {
+	if p.IsForeign() {
+		return p.OriginalValue
+	}
+	return p.TotalValue
+}
+
+func (p *Product) SetForeign(currency string, amount, rate, iof float64) error {
+	currency, err := money.NormalizeCurrency(currency)
+	if err != nil {
+		return err
+	}
+	if amount <= 0 {
+		return ErrInvalidValue
+	}
+
+	if currency == money.BaseCurrency {
+		p.Currency, p.OriginalValue, p.ExchangeRate, p.IOF = "", 0, 0, 0
+		p.SetAmount(amount)
+		return nil
+	}
+	if rate <= 0 {
+		return money.ErrInvalidRate
+	}
+	if iof < 0 || iof > 100 {
+		return money.ErrInvalidIOF
+	}
+
+	p.Currency, p.ExchangeRate, p.IOF = currency, rate, iof
+	p.SetAmount(amount)
+	return nil
+}
+
+func (p *Product) SetAmount(amount float64) {
+	if p.IsForeign() {
+		p.OriginalValue = amount
+		p.TotalValue = money.Convert(amount, p.ExchangeRate, p.IOF)
+	} else {
+		p.TotalValue = amount
+	}
+	if p.Installments > 0 {
+		p.Parcel = p.TotalValue / float64(p.Installments)
+	}
+}
+
+func (p *Product) SetTotalValue(total float64) {
+	if p.IsForeign() {
+		p.SetAmount(total / money.Convert(1, p.ExchangeRate, p.IOF))
+		return
+	}
+	p.SetAmount(total)
+}
@@ -0,0 +1,334 @@
+package product
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventAdd            EventType = "add"
+	EventEdit           EventType = "edit"
+	EventRemove         EventType = "remove"
+	EventAnticipate     EventType = "anticipate"
+	EventPay            EventType = "pay"
+	EventMonthlyProfit  EventType = "monthly_profit"
+	EventSafePercentage EventType = "safe_percentage"
+	EventPerson         EventType = "person"
+	EventOwner          EventType = "owner"
+	EventUndo           EventType = "undo"
+	EventRedo           EventType = "redo"
+)
+
+var ErrProductNotFound = errors.New("produto não encontrado")
+var ErrEventNotFound = errors.New("evento não encontrado no histórico")
+var ErrNothingToUndo = errors.New("nada para desfazer")
+var ErrNothingToRedo = errors.New("nada para refazer")
+
+type Event struct {
+	Seq          int       `json:"seq"`
+	Type         EventType `json:"type"`
+	Time         time.Time `json:"time"`
+	ProductID    int       `json:"product_id,omitempty"`
+	Index        int       `json:"index,omitempty"`
+	Before       *Product  `json:"before,omitempty"`
+	After        *Product  `json:"after,omitempty"`
+	OldValue     float64   `json:"old_value,omitempty"`
+	NewValue     float64   `json:"new_value,omitempty"`
+	Target       int       `json:"target,omitempty"`
+	PersonBefore *Person   `json:"person_before,omitempty"`
+	PersonAfter  *Person   `json:"person_after,omitempty"`
+	OldName      string    `json:"old_name,omitempty"`
+	NewName      string    `json:"new_name,omitempty"`
+}
+
+func (l *ProductList) Record(e Event) (Event, error) {
+	e.Seq = l.lastSeq() + 1
+	if err := l.Apply(e); err != nil {
+		return e, err
+	}
+	l.Events = append(l.Events, e)
+	return e, nil
+}
+
+func (l *ProductList) Apply(e Event) error {
+	switch e.Type {
+	case EventUndo:
+		target, ok := l.eventBySeq(e.Target)
+		if !ok {
+			return ErrEventNotFound
+		}
+		return l.revert(target)
+	case EventRedo:
+		target, ok := l.eventBySeq(e.Target)
+		if !ok {
+			return ErrEventNotFound
+		}
+		return l.apply(target)
+	default:
+		return l.apply(e)
+	}
+}
+
+func (l *ProductList) Replay(events []Event) error {
+	for _, e := range events {
+		if e.Seq <= l.JournalSeq {
+			continue
+		}
+		if err := l.Apply(e); err != nil {
+			return err
+		}
+		l.JournalSeq = e.Seq
+	}
+	return nil
+}
+
+func (l *ProductList) PendingEvents() []Event {
+	var pending []Event
+	for _, e := range l.Events {
+		if e.Seq > l.JournalSeq {
+			pending = append(pending, e)
+		}
+	}
+	return pending
+}
+
+func (l *ProductList) Merge(events []Event) int {
+	renumbered := make(map[int]int)
+	reassigned := make(map[int]int)
+	skipped := 0
+
+	for _, e := range events {
+		if e.Type == EventUndo || e.Type == EventRedo {
+			if seq, ok := renumbered[e.Target]; ok {
+				e.Target = seq
+			}
+		}
+
+		if e.Type == EventAdd && e.ProductID < l.NextID {
+			reassigned[e.ProductID] = l.NextID
+		}
+		if id, ok := reassigned[e.ProductID]; ok {
+			e = e.withProductID(id)
+		}
+
+		oldSeq := e.Seq
+		recorded, err := l.Record(e)
+		if err != nil {
+			skipped++
+			continue
+		}
+		renumbered[oldSeq] = recorded.Seq
+	}
+	return skipped
+}
+
+func (e Event) withProductID(id int) Event {
+	e.ProductID = id
+	if e.Before != nil {
+		before := *e.Before
+		before.ID = id
+		e.Before = &before
+	}
+	if e.After != nil {
+		after := *e.After
+		after.ID = id
+		e.After = &after
+	}
+	return e
+}
+
+func (l *ProductList) ProductHistory(id int) []Event {
+	var history []Event
+	for _, e := range l.Events {
+		productID := e.ProductID
+		if e.Type == EventUndo || e.Type == EventRedo {
+			target, _ := l.eventBySeq(e.Target)
+			productID = target.ProductID
+		}
+		if productID == id {
+			history = append(history, e)
+		}
+	}
+	return history
+}
+
+func (l *ProductList) HistoryProducts() []Product {
+	products := slices.Clone(l.Products)
+	removed := make(map[int]int)
+	for _, e := range l.Events {
+		if e.ProductID == 0 {
+			continue
+		}
+		if _, ok := l.FindByID(e.ProductID); ok {
+			continue
+		}
+		snapshot := e.After
+		if snapshot == nil {
+			snapshot = e.Before
+		}
+		if snapshot == nil {
+			continue
+		}
+		if i, ok := removed[e.ProductID]; ok {
+			products[i] = *snapshot
+			continue
+		}
+		removed[e.ProductID] = len(products)
+		products = append(products, *snapshot)
+	}
+	return products
+}
+
+func (l *ProductList) EventBySeq(seq int) (Event, bool) {
+	return l.eventBySeq(seq)
+}
+
+func (l *ProductList) Undo(now time.Time) (Event, error) {
+	undo, _ := l.undoRedoStacks()
+	if len(undo) == 0 {
+		return Event{}, ErrNothingToUndo
+	}
+
+	target, _ := l.eventBySeq(undo[len(undo)-1])
+	if _, err := l.Record(Event{Type: EventUndo, Time: now, Target: target.Seq}); err != nil {
+		return target, err
+	}
+	return target, nil
+}
+
+func (l *ProductList) Redo(now time.Time) (Event, error) {
+	_, redo := l.undoRedoStacks()
+	if len(redo) == 0 {
+		return Event{}, ErrNothingToRedo
+	}
+
+	target, _ := l.eventBySeq(redo[len(redo)-1])
+	if _, err := l.Record(Event{Type: EventRedo, Time: now, Target: target.Seq}); err != nil {
+		return target, err
+	}
+	return target, nil
+}
+
+func (l *ProductList) NextUndo() (Event, bool) {
+	undo, _ := l.undoRedoStacks()
+	if len(undo) == 0 {
+		return Event{}, false
+	}
+	return l.eventBySeq(undo[len(undo)-1])
+}
+
+func (l *ProductList) NextRedo() (Event, bool) {
+	_, redo := l.undoRedoStacks()
+	if len(redo) == 0 {
+		return Event{}, false
+	}
+	return l.eventBySeq(redo[len(redo)-1])
+}
+
+func (l *ProductList) undoRedoStacks() ([]int, []int) {
+	var undo, redo []int
+	for _, e := range l.Events {
+		switch e.Type {
+		case EventUndo:
+			if len(undo) > 0 {
+				undo = undo[:len(undo)-1]
+			}
+			redo = append(redo, e.Target)
+		case EventRedo:
+			if len(redo) > 0 {
+				redo = redo[:len(redo)-1]
+			}
+			undo = append(undo, e.Target)
+		default:
+			undo = append(undo, e.Seq)
+			redo = nil
+		}
+	}
+	return undo, redo
+}
+
+func (l *ProductList) apply(e Event) error {
+	switch e.Type {
+	case EventAdd:
+		l.insert(e.Index, *e.After)
+	case EventRemove:
+		idx, ok := l.FindByID(e.ProductID)
+		if !ok {
+			return ErrProductNotFound
+		}
+		l.Products = slices.Delete(l.Products, idx, idx+1)
+	case EventEdit, EventAnticipate, EventPay:
+		idx, ok := l.FindByID(e.ProductID)
+		if !ok {
+			return ErrProductNotFound
+		}
+		l.Products[idx] = *e.After
+	case EventMonthlyProfit:
+		l.MonthlyProfit = e.NewValue
+	case EventSafePercentage:
+		l.SafePercentage = e.NewValue
+	case EventPerson:
+		return l.applyPerson(e.PersonBefore, e.PersonAfter, e.Index)
+	case EventOwner:
+		l.Owner = e.NewName
+	}
+	return nil
+}
+
+func (l *ProductList) revert(e Event) error {
+	switch e.Type {
+	case EventAdd:
+		idx, ok := l.FindByID(e.ProductID)
+		if !ok {
+			return ErrProductNotFound
+		}
+		l.Products = slices.Delete(l.Products, idx, idx+1)
+	case EventRemove:
+		l.insert(e.Index, *e.Before)
+	case EventEdit, EventAnticipate, EventPay:
+		idx, ok := l.FindByID(e.ProductID)
+		if !ok {
+			return ErrProductNotFound
+		}
+		l.Products[idx] = *e.Before
+	case EventMonthlyProfit:
+		l.MonthlyProfit = e.OldValue
+	case EventSafePercentage:
+		l.SafePercentage = e.OldValue
+	case EventPerson:
+		return l.applyPerson(e.PersonAfter, e.PersonBefore, e.Index)
+	case EventOwner:
+		l.Owner = e.OldName
+	}
+	return nil
+}
+
+func (l *ProductList) insert(index int, p Product) {
+	if p.ID >= l.NextID {
+		l.NextID = p.ID + 1
+	}
+	if index < 0 || index > len(l.Products) {
+		index = len(l.Products)
+	}
+	l.Products = slices.Insert(l.Products, index, p)
+}
+
+func (l *ProductList) eventBySeq(seq int) (Event, bool) {
+	for _, e := range l.Events {
+		if e.Seq == seq {
+			return e, true
+		}
+	}
+	return Event{}, false
+}
+
+func (l *ProductList) lastSeq() int {
+	last := l.JournalSeq
+	if n := len(l.Events); n > 0 && l.Events[n-1].Seq > last {
+		last = l.Events[n-1].Seq
+	}
+	return last
+}
@@ -0,0 +1,182 @@
+package product
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2026, time.March, 15, 12, 0, 0, 0, time.Local)
+
+func newTestProduct(t *testing.T, name string, total float64, installments int) Product {
+	t.Helper()
+	p, err := New(name, total, installments, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func addProduct(t *testing.T, l *ProductList, name string) Product {
+	t.Helper()
+	p, err := l.Add(newTestProduct(t, name, 300, 3), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func names(l ProductList) []string {
+	var names []string
+	for _, p := range l.Products {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func TestReplaySkipsSavedEvents(t *testing.T) {
+	var source ProductList
+	addProduct(t, &source, "Notebook")
+	addProduct(t, &source, "Fone")
+	if err := source.SetMonthlyProfit(5000, now); err != nil {
+		t.Fatal(err)
+	}
+
+	saved := ProductList{NextID: 2, JournalSeq: 1}
+	first := *source.Events[0].After
+	saved.Products = []Product{first}
+	if err := saved.Replay(source.Events); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := names(saved); len(got) != 2 || got[0] != "Notebook" || got[1] != "Fone" {
+		t.Errorf("produtos = %v, esperado [Notebook Fone]", got)
+	}
+	if saved.MonthlyProfit != 5000 || saved.JournalSeq != 3 {
+		t.Errorf("lucro %.2f e seq %d, esperado 5000 e 3", saved.MonthlyProfit, saved.JournalSeq)
+	}
+}
+
+func TestUndoRedo(t *testing.T) {
+	var l ProductList
+	addProduct(t, &l, "Notebook")
+	if err := l.SetMonthlyProfit(5000, now); err != nil {
+		t.Fatal(err)
+	}
+
+	if target, err := l.Undo(now); err != nil || target.Type != EventMonthlyProfit {
+		t.Fatalf("Undo = %v, %v", target.Type, err)
+	}
+	if target, err := l.Undo(now); err != nil || target.Type != EventAdd {
+		t.Fatalf("Undo = %v, %v", target.Type, err)
+	}
+	if len(l.Products) != 0 || l.MonthlyProfit != 0 {
+		t.Fatalf("depois de desfazer tudo: %v, lucro %.2f", names(l), l.MonthlyProfit)
+	}
+	if _, err := l.Undo(now); !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("erro = %v, esperado %v", err, ErrNothingToUndo)
+	}
+
+	if target, err := l.Redo(now); err != nil || target.Type != EventAdd {
+		t.Fatalf("Redo = %v, %v", target.Type, err)
+	}
+	if next, ok := l.NextRedo(); !ok || next.Type != EventMonthlyProfit {
+		t.Errorf("próximo refazer = %v, %v; esperado lucro mensal", next.Type, ok)
+	}
+	if next, ok := l.NextUndo(); !ok || next.Type != EventAdd {
+		t.Errorf("próximo desfazer = %v, %v; esperado adicionar", next.Type, ok)
+	}
+}
+
+func TestNewChangeClearsRedo(t *testing.T) {
+	var l ProductList
+	addProduct(t, &l, "Notebook")
+	if _, err := l.Undo(now); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := l.NextRedo(); !ok {
+		t.Fatal("esperava algo para refazer")
+	}
+
+	addProduct(t, &l, "Fone")
+	if _, ok := l.NextRedo(); ok {
+		t.Error("uma alteração nova deveria limpar o refazer")
+	}
+	if _, err := l.Redo(now); !errors.Is(err, ErrNothingToRedo) {
+		t.Errorf("erro = %v, esperado %v", err, ErrNothingToRedo)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	var base ProductList
+	notebook := addProduct(t, &base, "Notebook")
+	fone := addProduct(t, &base, "Fone")
+	base.JournalSeq = base.Events[len(base.Events)-1].Seq
+
+	other := base
+	other.Products = append([]Product(nil), base.Products...)
+	other.Events = append([]Event(nil), base.Events...)
+	if err := other.Remove(fone.ID, now); err != nil {
+		t.Fatal(err)
+	}
+	addProduct(t, &other, "Mouse")
+	other.JournalSeq = other.Events[len(other.Events)-1].Seq
+
+	mine := base
+	mine.Products = append([]Product(nil), base.Products...)
+	mine.Events = append([]Event(nil), base.Events...)
+	edited := fone
+	edited.Name = "Fone JBL"
+	if err := mine.Update(edited, now); err != nil {
+		t.Fatal(err)
+	}
+	renamed := notebook
+	renamed.Name = "Notebook Dell"
+	if err := mine.Update(renamed, now); err != nil {
+		t.Fatal(err)
+	}
+	keyboard := addProduct(t, &mine, "Teclado")
+
+	if skipped := other.Merge(mine.PendingEvents()); skipped != 1 {
+		t.Errorf("%d alteração(ões) ignorada(s), esperado 1 (edição de produto removido)", skipped)
+	}
+
+	if got := names(other); len(got) != 3 || got[0] != "Notebook Dell" || got[1] != "Mouse" || got[2] != "Teclado" {
+		t.Errorf("produtos = %v, esperado [Notebook Dell Mouse Teclado]", got)
+	}
+	idx, ok := other.FindByID(keyboard.ID + 1)
+	if !ok || other.Products[idx].Name != "Teclado" {
+		t.Errorf("o produto adicionado deveria ganhar um ID novo, produtos: %+v", other.Products)
+	}
+	if pending := other.PendingEvents(); len(pending) != 2 {
+		t.Errorf("%d eventos pendentes, esperado 2", len(pending))
+	}
+}
+
+func TestHistoryProducts(t *testing.T) {
+	var l ProductList
+	notebook := addProduct(t, &l, "Notebook")
+	fone := addProduct(t, &l, "Fone")
+	edited := fone
+	edited.Name = "Fone JBL"
+	if err := l.Update(edited, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Remove(fone.ID, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SetMonthlyProfit(5000, now); err != nil {
+		t.Fatal(err)
+	}
+
+	products := l.HistoryProducts()
+	if len(products) != 2 || products[0].ID != notebook.ID || products[1].ID != fone.ID {
+		t.Fatalf("produtos = %+v, esperado Notebook e o Fone removido", products)
+	}
+	if products[1].Name != "Fone JBL" {
+		t.Errorf("produto removido = %s, esperado o último nome (Fone JBL)", products[1].Name)
+	}
+	if history := l.ProductHistory(fone.ID); len(history) != 3 {
+		t.Errorf("%d eventos no histórico do produto removido, esperado 3", len(history))
+	}
+}
@@ -0,0 +1,80 @@
+package product
+
+import (
+	"slices"
+	"time"
+)
+
+func (l *ProductList) Add(p Product, now time.Time) (Product, error) {
+	l.EnsureIDs()
+	p.ID = l.NextID
+
+	_, err := l.Record(Event{Type: EventAdd, Time: now, ProductID: p.ID, Index: len(l.Products), After: &p})
+	return p, err
+}
+
+func (l *ProductList) Update(p Product, now time.Time) error {
+	return l.replace(EventEdit, p, now)
+}
+
+func (l *ProductList) Anticipate(id int, installments int, now time.Time) error {
+	idx, ok := l.FindByID(id)
+	if !ok {
+		return ErrProductNotFound
+	}
+
+	p := l.Products[idx]
+	p.Installments -= installments
+	return l.replace(EventAnticipate, p, now)
+}
+
+func (l *ProductList) MarkPaid(id int, number int, now time.Time) error {
+	idx, ok := l.FindByID(id)
+	if !ok {
+		return ErrProductNotFound
+	}
+
+	p := l.Products[idx]
+	if number < 1 || number > p.Installments {
+		return ErrInvalidInstallments
+	}
+	if p.IsPaid(number) {
+		return ErrAlreadyPaid
+	}
+
+	p.Paid = append(slices.Clone(p.Paid), number)
+	slices.Sort(p.Paid)
+	return l.replace(EventPay, p, now)
+}
+
+func (l *ProductList) Remove(id int, now time.Time) error {
+	idx, ok := l.FindByID(id)
+	if !ok {
+		return ErrProductNotFound
+	}
+
+	before := l.Products[idx]
+	_, err := l.Record(Event{Type: EventRemove, Time: now, ProductID: id, Index: idx, Before: &before})
+	return err
+}
+
+func (l *ProductList) SetMonthlyProfit(value float64, now time.Time) error {
+	_, err := l.Record(Event{Type: EventMonthlyProfit, Time: now, OldValue: l.MonthlyProfit, NewValue: value})
+	return err
+}
+
+func (l *ProductList) SetSafePercentage(value float64, now time.Time) error {
+	_, err := l.Record(Event{Type: EventSafePercentage, Time: now, OldValue: l.SafePercentage, NewValue: value})
+	return err
+}
+
+func (l *ProductList) replace(eventType EventType, p Product, now time.Time) error {
+	idx, ok := l.FindByID(p.ID)
+	if !ok {
+		return ErrProductNotFound
+	}
+
+	before := l.Products[idx]
+	_, err := l.Record(Event{Type: eventType, Time: now, ProductID: p.ID, Before: &before, After: &p})
+	return err
+}
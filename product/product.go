@@ -1,13 +1,27 @@
 package product
 
-import "time"
+import (
+	"math"
+	"strings"
+	"time"
+)
 
 type Product struct {
-	Name         string    `json:"name"`
-	Parcel       float64   `json:"parcel"`
-	TotalValue   float64   `json:"total_value"`
-	Installments int       `json:"installments"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	Category      string    `json:"category,omitempty"`
+	Card          string    `json:"card,omitempty"`
+	Parcel        float64   `json:"parcel"`
+	TotalValue    float64   `json:"total_value"`
+	Installments  int       `json:"installments"`
+	CreatedAt     time.Time `json:"created_at"`
+	Currency      string    `json:"currency,omitempty"`
+	OriginalValue float64   `json:"original_value,omitempty"`
+	ExchangeRate  float64   `json:"exchange_rate,omitempty"`
+	IOF           float64   `json:"iof,omitempty"`
+	Paid          []int     `json:"paid,omitempty"`
+	PaidBy        string    `json:"paid_by,omitempty"`
+	Split         []Share   `json:"split,omitempty"`
 }
 
 type ProductList struct {
@@ -16,4 +30,43 @@ type ProductList struct {
 	Month          int       `json:"month"`
 	Year           int       `json:"year"`
 	SafePercentage float64   `json:"safe_percentage"`
+	Revision       int       `json:"revision"`
+	NextID         int       `json:"next_id"`
+	JournalSeq     int       `json:"journal_seq"`
+	Owner          string    `json:"owner,omitempty"`
+	People         []Person  `json:"people,omitempty"`
+	Events         []Event   `json:"-"`
+}
+
+func (l *ProductList) FindByID(id int) (int, bool) {
+	for i, p := range l.Products {
+		if p.ID == id {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (l *ProductList) EnsureIDs() {
+	for _, p := range l.Products {
+		if p.ID >= l.NextID {
+			l.NextID = p.ID + 1
+		}
+	}
+	if l.NextID == 0 {
+		l.NextID = 1
+	}
+	for i := range l.Products {
+		if l.Products[i].ID == 0 {
+			l.Products[i].ID = l.NextID
+			l.NextID++
+		}
+	}
+}
+
+func (p Product) SameAs(other Product) bool {
+	return fold(strings.TrimSpace(p.Name)) == fold(strings.TrimSpace(other.Name)) &&
+		math.Round(p.TotalValue*100) == math.Round(other.TotalValue*100) &&
+		p.Installments == other.Installments &&
+		p.CreatedAt.Format(time.DateOnly) == other.CreatedAt.Format(time.DateOnly)
 }
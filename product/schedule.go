@@ -0,0 +1,108 @@
+package product
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+)
+
+func monthIndex(year, month int) int {
+	return year*12 + month - 1
+}
+
+func (p Product) monthOffset(targetYear, targetMonth int) int {
+	return monthIndex(targetYear, targetMonth) - monthIndex(p.CreatedAt.Year(), int(p.CreatedAt.Month()))
+}
+
+func (p Product) IsActiveInMonth(targetYear, targetMonth int) bool {
+	offset := p.monthOffset(targetYear, targetMonth)
+	return offset >= 0 && offset < p.Installments
+}
+
+func (p Product) InstallmentNumber(targetYear, targetMonth int) int {
+	number := p.monthOffset(targetYear, targetMonth) + 1
+
+	if number < 1 {
+		return 1
+	}
+	if number > p.Installments {
+		return p.Installments
+	}
+	return number
+}
+
+func (p Product) IsPaid(number int) bool {
+	return slices.Contains(p.Paid, number)
+}
+
+func (p Product) InstallmentMonth(number int) (int, int) {
+	index := monthIndex(p.CreatedAt.Year(), int(p.CreatedAt.Month())) + number - 1
+	return index / 12, index%12 + 1
+}
+
+func (l ProductList) ActiveInMonth(targetYear, targetMonth int) ([]Product, float64) {
+	var activeProducts []Product
+	var totalParcel float64
+
+	for _, p := range l.Products {
+		if p.IsActiveInMonth(targetYear, targetMonth) {
+			activeProducts = append(activeProducts, p)
+			totalParcel += p.Parcel
+		}
+	}
+
+	return activeProducts, totalParcel
+}
+
+type Installment struct {
+	Product Product
+	Number  int
+	Year    int
+	Month   int
+	Due     time.Time
+	Amount  float64
+}
+
+func (p Product) Schedule() []Installment {
+	installments := make([]Installment, 0, p.Installments)
+	parcelCents := math.Round(p.Parcel * 100)
+	totalCents := math.Round(p.Parcel * float64(p.Installments) * 100)
+
+	for number := 1; number <= p.Installments; number++ {
+		year, month := p.InstallmentMonth(number)
+		cents := parcelCents
+		if number == p.Installments {
+			cents = totalCents - parcelCents*float64(p.Installments-1)
+		}
+		installments = append(installments, Installment{
+			Product: p,
+			Number:  number,
+			Year:    year,
+			Month:   month,
+			Due:     dueDate(year, month, p.CreatedAt.Day()),
+			Amount:  cents / 100,
+		})
+	}
+	return installments
+}
+
+func (l ProductList) Schedule() []Installment {
+	var installments []Installment
+	for _, p := range l.Products {
+		installments = append(installments, p.Schedule()...)
+	}
+
+	sort.SliceStable(installments, func(i, j int) bool {
+		if !installments[i].Due.Equal(installments[j].Due) {
+			return installments[i].Due.Before(installments[j].Due)
+		}
+		return installments[i].Product.ID < installments[j].Product.ID
+	})
+	return installments
+}
+
+func dueDate(year, month, day int) time.Time {
+	lastDay := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.Local).Day()
+	return time.Date(year, time.Month(month), min(day, lastDay), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,146 @@
+package product
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+)
+
+var accentFolder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a", "å", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+func fold(s string) string {
+	return accentFolder.Replace(strings.ToLower(strings.TrimSpace(s)))
+}
+
+func (l ProductList) Search(query string) []int {
+	terms := strings.Fields(fold(query))
+	if len(terms) == 0 {
+		return nil
+	}
+
+	type match struct {
+		index int
+		score int
+	}
+	var matches []match
+
+	for i, p := range l.Products {
+		total := 0
+		for _, term := range terms {
+			score := p.matchScore(term)
+			if score == 0 {
+				total = 0
+				break
+			}
+			total += score
+		}
+		if total > 0 {
+			matches = append(matches, match{i, total})
+		}
+	}
+
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].score > matches[b].score
+	})
+
+	indexes := make([]int, len(matches))
+	for i, m := range matches {
+		indexes[i] = m.index
+	}
+	return indexes
+}
+
+func (p Product) matchScore(term string) int {
+	name := fold(p.Name)
+	category := fold(p.Category)
+	card := fold(p.Card)
+
+	switch {
+	case hasWordPrefix(name, term):
+		return 5
+	case strings.Contains(name, term):
+		return 4
+	case category != "" && strings.Contains(category, term):
+		return 3
+	case card != "" && strings.Contains(card, term):
+		return 3
+	}
+
+	if value, digits, ok := parseSearchValue(term); ok {
+		if math.Abs(p.TotalValue-value) < 0.005 || math.Abs(p.Parcel-value) < 0.005 {
+			return 3
+		}
+		if strings.HasPrefix(fmt.Sprintf("%.2f", p.TotalValue), digits) || strings.HasPrefix(fmt.Sprintf("%.2f", p.Parcel), digits) {
+			return 2
+		}
+		return 0
+	}
+
+	if isSubsequence(name, term) {
+		return 1
+	}
+	return 0
+}
+
+func hasWordPrefix(s, prefix string) bool {
+	for _, word := range strings.Fields(s) {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSubsequence(s, sub string) bool {
+	runes := []rune(sub)
+	i := 0
+	for _, r := range s {
+		if i < len(runes) && r == runes[i] {
+			i++
+		}
+	}
+	return i == len(runes)
+}
+
+func parseSearchValue(term string) (float64, string, bool) {
+	value, err := money.Parse(term)
+	if err != nil || value <= 0 {
+		return 0, "", false
+	}
+	return value, strconv.FormatFloat(value, 'f', -1, 64), true
+}
+
+func (p Product) NameMatches(text string) bool {
+	name, text := fold(p.Name), fold(text)
+	if name == "" || text == "" {
+		return false
+	}
+	if strings.Contains(text, name) || strings.Contains(name, text) {
+		return true
+	}
+
+	words := strings.FieldsFunc(text, isSeparator)
+	for _, word := range strings.FieldsFunc(name, isSeparator) {
+		if len(word) >= 4 && slices.Contains(words, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
@@ -0,0 +1,254 @@
+package product
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+)
+
+var ErrPersonNotFound = errors.New("pessoa não encontrada")
+var ErrPersonInUse = errors.New("pessoa usada na divisão de algum produto")
+var ErrPersonExists = errors.New("já existe uma pessoa com esse nome")
+var ErrOwnerIncome = errors.New("a renda do titular é o lucro mensal do perfil")
+var ErrInvalidShare = errors.New("parte inválida: use uma porcentagem entre 0 e 100 ou um valor positivo")
+var ErrDuplicateShare = errors.New("pessoa repetida na divisão")
+var ErrSplitExceeds = errors.New("as partes somam mais que o valor total do produto")
+
+type Person struct {
+	Name          string  `json:"name"`
+	MonthlyProfit float64 `json:"monthly_profit"`
+}
+
+type Share struct {
+	Person  string  `json:"person,omitempty"`
+	Percent float64 `json:"percent,omitempty"`
+	Amount  float64 `json:"amount,omitempty"`
+}
+
+func SamePerson(a, b string) bool {
+	return fold(strings.TrimSpace(a)) == fold(strings.TrimSpace(b))
+}
+
+func (l ProductList) FindPerson(name string) (int, bool) {
+	for i, person := range l.People {
+		if SamePerson(person.Name, name) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (l ProductList) PersonKey(name string) (string, bool) {
+	if strings.TrimSpace(name) == "" || (l.Owner != "" && SamePerson(name, l.Owner)) {
+		return "", true
+	}
+	if idx, ok := l.FindPerson(name); ok {
+		return l.People[idx].Name, true
+	}
+	return "", false
+}
+
+func (l ProductList) DisplayName(key string) string {
+	if key == "" {
+		return l.Owner
+	}
+	return key
+}
+
+func (l ProductList) IncomeOf(key string) (float64, bool) {
+	if key == "" {
+		return l.MonthlyProfit, true
+	}
+	if idx, ok := l.FindPerson(key); ok {
+		return l.People[idx].MonthlyProfit, true
+	}
+	return 0, false
+}
+
+func (l ProductList) PersonNames() []string {
+	names := []string{l.Owner}
+	for _, person := range l.People {
+		names = append(names, person.Name)
+	}
+	return names
+}
+
+func (l *ProductList) SetOwner(name string, now time.Time) error {
+	name = strings.TrimSpace(name)
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if _, ok := l.FindPerson(name); ok {
+		return ErrPersonExists
+	}
+
+	_, err := l.Record(Event{Type: EventOwner, Time: now, OldName: l.Owner, NewName: name})
+	return err
+}
+
+func (l *ProductList) SetPerson(name string, monthlyProfit float64, now time.Time) error {
+	name = strings.TrimSpace(name)
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if l.Owner != "" && SamePerson(name, l.Owner) {
+		return ErrOwnerIncome
+	}
+	if err := ValidateMonthlyProfit(monthlyProfit); err != nil {
+		return err
+	}
+
+	e := Event{Type: EventPerson, Time: now, Index: len(l.People), PersonAfter: &Person{Name: name, MonthlyProfit: monthlyProfit}}
+	if idx, ok := l.FindPerson(name); ok {
+		before := l.People[idx]
+		e.Index = idx
+		e.PersonBefore = &before
+		e.PersonAfter.Name = before.Name
+	}
+
+	_, err := l.Record(e)
+	return err
+}
+
+func (l *ProductList) RemovePerson(name string, now time.Time) error {
+	idx, ok := l.FindPerson(name)
+	if !ok {
+		return ErrPersonNotFound
+	}
+	before := l.People[idx]
+	for _, p := range l.Products {
+		if slices.Contains(p.Participants(), before.Name) {
+			return ErrPersonInUse
+		}
+	}
+
+	_, err := l.Record(Event{Type: EventPerson, Time: now, Index: idx, PersonBefore: &before})
+	return err
+}
+
+func (l *ProductList) SetSplit(id int, paidBy string, shares []Share, now time.Time) error {
+	idx, ok := l.FindByID(id)
+	if !ok {
+		return ErrProductNotFound
+	}
+
+	p := l.Products[idx]
+	if p.PaidBy, ok = l.PersonKey(paidBy); !ok {
+		return ErrPersonNotFound
+	}
+
+	p.Split = nil
+	var assigned float64
+	for _, share := range shares {
+		key, ok := l.PersonKey(share.Person)
+		if !ok {
+			return ErrPersonNotFound
+		}
+		if slices.ContainsFunc(p.Split, func(s Share) bool { return s.Person == key }) {
+			return ErrDuplicateShare
+		}
+		if (share.Percent > 0) == (share.Amount > 0) || share.Percent < 0 || share.Percent > 100 || share.Amount < 0 {
+			return ErrInvalidShare
+		}
+		share.Person = key
+		assigned += share.ratio(p.TotalValue)
+		p.Split = append(p.Split, share)
+	}
+	if assigned > 1.000001 {
+		return ErrSplitExceeds
+	}
+
+	return l.Update(p, now)
+}
+
+func (l *ProductList) applyPerson(before, after *Person, index int) error {
+	if before == nil {
+		if _, ok := l.FindPerson(after.Name); ok {
+			return ErrPersonExists
+		}
+		if index < 0 || index > len(l.People) {
+			index = len(l.People)
+		}
+		l.People = slices.Insert(l.People, index, *after)
+		return nil
+	}
+
+	idx, ok := l.FindPerson(before.Name)
+	if !ok {
+		return ErrPersonNotFound
+	}
+	if after == nil {
+		l.People = slices.Delete(l.People, idx, idx+1)
+	} else {
+		l.People[idx] = *after
+	}
+	return nil
+}
+
+func (s Share) ratio(totalValue float64) float64 {
+	if s.Percent > 0 {
+		return s.Percent / 100
+	}
+	if totalValue <= 0 {
+		return 0
+	}
+	return s.Amount / totalValue
+}
+
+func (p Product) IsShared() bool {
+	return len(p.Split) > 0 || p.PaidBy != ""
+}
+
+func (p Product) Participants() []string {
+	participants := []string{p.PaidBy}
+	for _, share := range p.Split {
+		if !slices.Contains(participants, share.Person) {
+			participants = append(participants, share.Person)
+		}
+	}
+	return participants
+}
+
+func (p Product) ShareOf(key string) float64 {
+	var assigned, share float64
+	for _, s := range p.Split {
+		ratio := s.ratio(p.TotalValue)
+		assigned += ratio
+		if s.Person == key {
+			share += ratio
+		}
+	}
+
+	if assigned > 1 {
+		return share / assigned
+	}
+	if p.PaidBy == key {
+		share += 1 - assigned
+	}
+	return math.Max(share, 0)
+}
+
+func ParseShare(person, value string) (Share, error) {
+	share := Share{Person: strings.TrimSpace(person)}
+	value = strings.TrimSpace(value)
+
+	if percent, ok := strings.CutSuffix(value, "%"); ok {
+		number, err := money.Parse(percent)
+		if err != nil || number <= 0 || number > 100 {
+			return share, ErrInvalidShare
+		}
+		share.Percent = number
+		return share, nil
+	}
+
+	amount, err := money.Parse(value)
+	if err != nil || amount <= 0 {
+		return share, ErrInvalidShare
+	}
+	share.Amount = amount
+	return share, nil
+}
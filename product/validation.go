@@ -0,0 +1,59 @@
+package product
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidName = errors.New("nome inválido")
+var ErrInvalidValue = errors.New("valor inválido")
+var ErrInvalidInstallments = errors.New("número de parcelas inválido")
+var ErrAlreadyPaid = errors.New("parcela já marcada como paga")
+var ErrInvalidPercentage = errors.New("porcentagem inválida: use um valor entre 0 e 100")
+
+func New(name string, totalValue float64, installments int, createdAt time.Time) (Product, error) {
+	if err := ValidateName(name); err != nil {
+		return Product{}, err
+	}
+	if totalValue <= 0 {
+		return Product{}, ErrInvalidValue
+	}
+	if installments < 1 {
+		return Product{}, ErrInvalidInstallments
+	}
+
+	return Product{
+		Name:         name,
+		Parcel:       totalValue / float64(installments),
+		TotalValue:   totalValue,
+		Installments: installments,
+		CreatedAt:    createdAt,
+	}, nil
+}
+
+func ValidateName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidName
+	}
+	if _, err := strconv.Atoi(name); err == nil {
+		return ErrInvalidName
+	}
+	return nil
+}
+
+func ValidateMonthlyProfit(profit float64) error {
+	if profit <= 0 {
+		return ErrInvalidValue
+	}
+	return nil
+}
+
+func ValidateSafePercentage(percentage float64) error {
+	if percentage <= 0 || percentage > 100 {
+		return ErrInvalidPercentage
+	}
+	return nil
+}
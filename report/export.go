@@ -0,0 +1,103 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/csvio"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+func ValidFormat(format string) bool {
+	return format == FormatText || format == FormatJSON || format == FormatCSV
+}
+
+func WriteJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func WriteSummaryCSV(w io.Writer, summaries ...MonthSummary) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{
+		"year", "month", "person", "monthly_profit", "total_parcel", "used_percent", "left_percent",
+		"reinvest_value", "safe_percentage", "spendable_percent", "spendable_value",
+		"remaining_spendable", "verdict", "active_products", "suggested_products", "suggested_total",
+	})
+
+	for _, s := range summaries {
+		suggested := make([]string, 0, len(s.Suggested))
+		for _, p := range s.Suggested {
+			suggested = append(suggested, p.Name)
+		}
+
+		writer.Write([]string{
+			strconv.Itoa(s.Year),
+			strconv.Itoa(s.Month),
+			s.Person,
+			formatNumber(s.MonthlyProfit),
+			formatNumber(s.TotalParcel),
+			formatNumber(s.UsedPercent),
+			formatNumber(s.LeftPercent),
+			formatNumber(s.ReinvestValue),
+			formatNumber(s.SafePercentage),
+			formatNumber(s.SpendablePercent),
+			formatNumber(s.SpendableValue),
+			formatNumber(s.RemainingSpendable),
+			string(s.Verdict),
+			strconv.Itoa(len(s.Products)),
+			strings.Join(suggested, ";"),
+			formatNumber(s.SuggestedTotal),
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func WriteProductsCSV(w io.Writer, lines []ProductLine) error {
+	writer := csv.NewWriter(w)
+	writer.Write(slices.Concat(csvio.Header, []string{"id", "parcel", "installment_number", "currency", "original_value"}))
+
+	for _, p := range lines {
+		writer.Write([]string{
+			p.Name,
+			formatNumber(p.TotalValue),
+			strconv.Itoa(p.Installments),
+			p.CreatedAt.Format(time.DateOnly),
+			p.Category,
+			p.Card,
+			strconv.Itoa(p.ID),
+			formatNumber(p.Parcel),
+			strconv.Itoa(p.InstallmentNumber),
+			p.Currency,
+			originalValue(p),
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func originalValue(p ProductLine) string {
+	if p.Currency == "" {
+		return ""
+	}
+	return formatNumber(p.OriginalValue)
+}
+
+func formatNumber(value float64) string {
+	return fmt.Sprintf("%.2f", value)
+}
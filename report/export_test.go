@@ -0,0 +1,127 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/csvio"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+func readCSV(t *testing.T, data string) [][]string {
+	t.Helper()
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return records
+}
+
+func TestWriteJSON(t *testing.T) {
+	summary := Summarize(productList(t, 5000, 70, 1000), 2026, int(time.March))
+
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, summary); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := decoded["person"]; ok {
+		t.Error("o resumo do titular não deveria ter o campo person")
+	}
+	if decoded["verdict"] != string(VerdictOK) || decoded["used_percent"] != 20.0 {
+		t.Errorf("JSON inesperado: %s", buf.String())
+	}
+	if products, ok := decoded["products"].([]any); !ok || len(products) != 1 {
+		t.Errorf("produtos = %v, esperado 1", decoded["products"])
+	}
+	if suggested, ok := decoded["suggested_products"].([]any); !ok || len(suggested) != 0 {
+		t.Errorf("sugestões = %v, esperado lista vazia", decoded["suggested_products"])
+	}
+}
+
+func TestWriteSummaryCSV(t *testing.T) {
+	list := sharedList(t, "")
+	addShared(t, &list, "Notebook", 1000, 10, "Ana", product.Share{Person: "", Percent: 30})
+	holder := Summarize(list, 2026, int(time.March))
+	ana, err := SummarizePerson(list, "Ana", 2026, int(time.April))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSummaryCSV(&buf, holder, ana); err != nil {
+		t.Fatal(err)
+	}
+	records := readCSV(t, buf.String())
+	if len(records) != 3 {
+		t.Fatalf("%d linhas, esperado 3", len(records))
+	}
+
+	header := records[0]
+	if len(header) != 16 || header[2] != "person" {
+		t.Fatalf("cabeçalho = %v", header)
+	}
+	tests := []struct {
+		column string
+		want   []string
+	}{
+		{column: "month", want: []string{"3", "4"}},
+		{column: "person", want: []string{"", "Ana"}},
+		{column: "monthly_profit", want: []string{"5000.00", "4000.00"}},
+		{column: "total_parcel", want: []string{"30.00", "70.00"}},
+		{column: "verdict", want: []string{"ok", "ok"}},
+		{column: "active_products", want: []string{"1", "1"}},
+	}
+	for _, tt := range tests {
+		i := slices.Index(header, tt.column)
+		if i < 0 {
+			t.Errorf("coluna %s ausente", tt.column)
+			continue
+		}
+		for row, want := range tt.want {
+			if got := records[row+1][i]; got != want {
+				t.Errorf("linha %d, %s = %q, esperado %q", row+1, tt.column, got, want)
+			}
+		}
+	}
+}
+
+func TestWriteProductsCSV(t *testing.T) {
+	list := productList(t, 5000, 70, 1000)
+	list.Products[0].Category = "Informática"
+	list.Products[0].Card = "Nubank"
+	lines := ActiveProducts(list, 2026, int(time.April))
+
+	var buf bytes.Buffer
+	if err := WriteProductsCSV(&buf, lines); err != nil {
+		t.Fatal(err)
+	}
+	records := readCSV(t, buf.String())
+	if len(records) != 2 {
+		t.Fatalf("%d linhas, esperado 2", len(records))
+	}
+	if header := records[0]; !slices.Equal(header[:len(csvio.Header)], csvio.Header) {
+		t.Errorf("cabeçalho = %v, esperado começar com %v", header, csvio.Header)
+	}
+	want := []string{"A produto", "3000.00", "3", "2026-03-15", "Informática", "Nubank", "1", "1000.00", "2", "", ""}
+	if !slices.Equal(records[1], want) {
+		t.Errorf("linha = %v, esperado %v", records[1], want)
+	}
+
+	rows, err := csvio.Read(strings.NewReader(buf.String()), nil, now)
+	if err != nil || len(rows) != 1 || !rows[0].Valid() {
+		t.Fatalf("reimportação = %+v, %v", rows, err)
+	}
+	if p := rows[0].Product; p.Name != "A produto" || p.TotalValue != 3000 || p.Installments != 3 || p.Card != "Nubank" {
+		t.Errorf("produto reimportado = %+v", p)
+	}
+}
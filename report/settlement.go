@@ -0,0 +1,141 @@
+package report
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+type Transfer struct {
+	From   string  `json:"from"`
+	To     string  `json:"to"`
+	Amount float64 `json:"amount"`
+}
+
+type SettlementItem struct {
+	Transfer
+	ProductID         int    `json:"product_id"`
+	Product           string `json:"product"`
+	Year              int    `json:"year"`
+	Month             int    `json:"month"`
+	InstallmentNumber int    `json:"installment_number"`
+	Installments      int    `json:"installments"`
+}
+
+type Balance struct {
+	Person string  `json:"person"`
+	Paid   float64 `json:"paid"`
+	Share  float64 `json:"share"`
+	Net    float64 `json:"net"`
+}
+
+type Settlement struct {
+	Year      int              `json:"year"`
+	Month     int              `json:"month"`
+	Months    int              `json:"months"`
+	Items     []SettlementItem `json:"items"`
+	Balances  []Balance        `json:"balances"`
+	Transfers []Transfer       `json:"transfers"`
+}
+
+func Settle(list product.ProductList, year, month, months int) Settlement {
+	s := Settlement{
+		Year:      year,
+		Month:     month,
+		Months:    months,
+		Items:     []SettlementItem{},
+		Balances:  []Balance{},
+		Transfers: []Transfer{},
+	}
+
+	balances := make(map[string]*Balance)
+	balance := func(key string) *Balance {
+		if b, ok := balances[key]; ok {
+			return b
+		}
+		b := &Balance{Person: list.DisplayName(key)}
+		balances[key] = b
+		return b
+	}
+
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
+	for i := 0; i < months; i++ {
+		date := start.AddDate(0, i, 0)
+		active, _ := list.ActiveInMonth(date.Year(), int(date.Month()))
+		for _, p := range active {
+			if !p.IsShared() {
+				continue
+			}
+
+			balance(p.PaidBy).Paid += p.Parcel
+			for _, key := range p.Participants() {
+				amount := p.Parcel * p.ShareOf(key)
+				balance(key).Share += amount
+				if key == p.PaidBy || amount < 0.005 {
+					continue
+				}
+				s.Items = append(s.Items, SettlementItem{
+					Transfer:          Transfer{From: list.DisplayName(key), To: list.DisplayName(p.PaidBy), Amount: roundCents(amount)},
+					ProductID:         p.ID,
+					Product:           p.Name,
+					Year:              date.Year(),
+					Month:             int(date.Month()),
+					InstallmentNumber: p.InstallmentNumber(date.Year(), int(date.Month())),
+					Installments:      p.Installments,
+				})
+			}
+		}
+	}
+
+	for _, b := range balances {
+		b.Net = roundCents(b.Paid - b.Share)
+		b.Paid = roundCents(b.Paid)
+		b.Share = roundCents(b.Share)
+		s.Balances = append(s.Balances, *b)
+	}
+	sort.Slice(s.Balances, func(i, j int) bool {
+		if s.Balances[i].Net != s.Balances[j].Net {
+			return s.Balances[i].Net > s.Balances[j].Net
+		}
+		return s.Balances[i].Person < s.Balances[j].Person
+	})
+	s.Transfers = settleBalances(s.Balances)
+
+	return s
+}
+
+func settleBalances(balances []Balance) []Transfer {
+	var creditors, debtors []Balance
+	for _, b := range balances {
+		if b.Net >= 0.01 {
+			creditors = append(creditors, b)
+		} else if b.Net <= -0.01 {
+			b.Net = -b.Net
+			debtors = append(debtors, b)
+		}
+	}
+	sort.SliceStable(debtors, func(i, j int) bool { return debtors[i].Net > debtors[j].Net })
+
+	transfers := []Transfer{}
+	for i, j := 0, 0; i < len(debtors) && j < len(creditors); {
+		amount := roundCents(math.Min(debtors[i].Net, creditors[j].Net))
+		if amount >= 0.01 {
+			transfers = append(transfers, Transfer{From: debtors[i].Person, To: creditors[j].Person, Amount: amount})
+		}
+		debtors[i].Net = roundCents(debtors[i].Net - amount)
+		creditors[j].Net = roundCents(creditors[j].Net - amount)
+		if debtors[i].Net < 0.01 {
+			i++
+		}
+		if creditors[j].Net < 0.01 {
+			j++
+		}
+	}
+	return transfers
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,132 @@
+package report
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+var now = time.Date(2026, time.March, 15, 12, 0, 0, 0, time.Local)
+
+func sharedList(t *testing.T, owner string) product.ProductList {
+	t.Helper()
+	list := product.ProductList{Owner: owner, MonthlyProfit: 5000, SafePercentage: 70}
+	if err := list.SetPerson("Ana", 4000, now); err != nil {
+		t.Fatal(err)
+	}
+	return list
+}
+
+func addShared(t *testing.T, list *product.ProductList, name string, total float64, installments int, paidBy string, shares ...product.Share) product.Product {
+	t.Helper()
+	p, err := product.New(name, total, installments, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, err = list.Add(p, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := list.SetSplit(p.ID, paidBy, shares, now); err != nil {
+		t.Fatal(err)
+	}
+	idx, _ := list.FindByID(p.ID)
+	return list.Products[idx]
+}
+
+func TestSettle(t *testing.T) {
+	tests := []struct {
+		name      string
+		owner     string
+		total     float64
+		paidBy    string
+		shares    []product.Share
+		from, to  string
+		amount    float64
+		payerPart float64
+	}{
+		{name: "parte em porcentagem", total: 1000, paidBy: "Ana", shares: []product.Share{{Person: "", Percent: 50}}, from: "", to: "Ana", amount: 50, payerPart: 50},
+		{name: "parte em valor", total: 300, shares: []product.Share{{Person: "Ana", Amount: 150}}, from: "Ana", to: "", amount: 50, payerPart: 50},
+		{name: "sobra fica com quem pagou", total: 1000, paidBy: "Ana", shares: []product.Share{{Person: "", Percent: 30}}, from: "", to: "Ana", amount: 30, payerPart: 70},
+		{name: "titular com nome", owner: "Pedro", total: 1000, paidBy: "Ana", shares: []product.Share{{Person: "Pedro", Percent: 25}}, from: "Pedro", to: "Ana", amount: 25, payerPart: 75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := sharedList(t, tt.owner)
+			installments := int(tt.total / 100)
+			p := addShared(t, &list, "Notebook", tt.total, installments, tt.paidBy, tt.shares...)
+
+			year, month := p.InstallmentMonth(1)
+			s := Settle(list, year, month, 1)
+			if len(s.Items) != 1 {
+				t.Fatalf("%d itens, esperado 1: %+v", len(s.Items), s.Items)
+			}
+			item := s.Items[0]
+			if item.From != tt.from || item.To != tt.to || item.Amount != tt.amount || item.InstallmentNumber != 1 {
+				t.Errorf("item = %+v, esperado %q → %q: %.2f na parcela 1", item, tt.from, tt.to, tt.amount)
+			}
+			if len(s.Transfers) != 1 || s.Transfers[0] != item.Transfer {
+				t.Errorf("transferências = %+v, esperado [%+v]", s.Transfers, item.Transfer)
+			}
+
+			for _, b := range s.Balances {
+				if b.Person == tt.to && (b.Paid != 100 || b.Share != tt.payerPart || b.Net != tt.amount) {
+					t.Errorf("saldo de quem pagou = %+v, esperado pago 100, parte %.2f, líquido %.2f", b, tt.payerPart, tt.amount)
+				}
+			}
+		})
+	}
+}
+
+func TestSettleAcrossMonths(t *testing.T) {
+	list := sharedList(t, "")
+	notebook := addShared(t, &list, "Notebook", 1000, 10, "Ana", product.Share{Person: "", Percent: 50})
+	addShared(t, &list, "Teclado", 300, 3, "", product.Share{Person: "Ana", Percent: 50})
+
+	year, month := notebook.InstallmentMonth(1)
+	s := Settle(list, year, month, 3)
+	if len(s.Items) != 6 {
+		t.Fatalf("%d itens, esperado 6", len(s.Items))
+	}
+	if len(s.Transfers) != 0 {
+		t.Errorf("as compras se compensam, transferências = %+v", s.Transfers)
+	}
+
+	s = Settle(list, year, month, 5)
+	if len(s.Transfers) != 1 || s.Transfers[0] != (Transfer{From: "", To: "Ana", Amount: 100}) {
+		t.Errorf("transferências = %+v, esperado titular deve 100,00 a Ana", s.Transfers)
+	}
+}
+
+func TestSetSplitExceeds(t *testing.T) {
+	list := sharedList(t, "")
+	p, err := product.New("Notebook", 1000, 10, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, err = list.Add(p, now); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		shares []product.Share
+		want   error
+	}{
+		{name: "porcentagens acima de 100%", shares: []product.Share{{Person: "", Percent: 60}, {Person: "Ana", Percent: 50}}, want: product.ErrSplitExceeds},
+		{name: "valores acima do total", shares: []product.Share{{Person: "Ana", Amount: 800}, {Person: "", Amount: 300}}, want: product.ErrSplitExceeds},
+		{name: "exatamente 100%", shares: []product.Share{{Person: "", Percent: 50}, {Person: "Ana", Amount: 500}}},
+		{name: "pessoa desconhecida", shares: []product.Share{{Person: "Bia", Percent: 10}}, want: product.ErrPersonNotFound},
+		{name: "titular repetido", shares: []product.Share{{Person: "", Percent: 10}, {Person: "", Percent: 10}}, want: product.ErrDuplicateShare},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := list.SetSplit(p.ID, "Ana", tt.shares, now); !errors.Is(err, tt.want) {
+				t.Errorf("erro = %v, esperado %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package report
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+type Verdict string
+
+const (
+	VerdictOK             Verdict = "ok"
+	VerdictNotRecommended Verdict = "not_recommended"
+)
+
+type ProductLine struct {
+	ID                int       `json:"id"`
+	Name              string    `json:"name"`
+	Category          string    `json:"category,omitempty"`
+	Card              string    `json:"card,omitempty"`
+	TotalValue        float64   `json:"total_value"`
+	Parcel            float64   `json:"parcel"`
+	InstallmentNumber int       `json:"installment_number"`
+	Installments      int       `json:"installments"`
+	CreatedAt         time.Time `json:"created_at"`
+	Currency          string    `json:"currency,omitempty"`
+	OriginalValue     float64   `json:"original_value,omitempty"`
+	Paid              bool      `json:"paid,omitempty"`
+	PaidBy            string    `json:"paid_by,omitempty"`
+	Share             float64   `json:"share,omitempty"`
+}
+
+type MonthSummary struct {
+	Person             string        `json:"person,omitempty"`
+	Year               int           `json:"year"`
+	Month              int           `json:"month"`
+	MonthlyProfit      float64       `json:"monthly_profit"`
+	TotalParcel        float64       `json:"total_parcel"`
+	UsedPercent        float64       `json:"used_percent"`
+	LeftPercent        float64       `json:"left_percent"`
+	ReinvestValue      float64       `json:"reinvest_value"`
+	SafePercentage     float64       `json:"safe_percentage"`
+	SpendablePercent   float64       `json:"spendable_percent"`
+	SpendableValue     float64       `json:"spendable_value"`
+	RemainingSpendable float64       `json:"remaining_spendable"`
+	Verdict            Verdict       `json:"verdict"`
+	Products           []ProductLine `json:"products"`
+	Suggested          []ProductLine `json:"suggested_products"`
+	SuggestedTotal     float64       `json:"suggested_total"`
+}
+
+func Summarize(list product.ProductList, year, month int) MonthSummary {
+	return summarize(list, "", list.MonthlyProfit, year, month)
+}
+
+func SummarizePerson(list product.ProductList, person string, year, month int) (MonthSummary, error) {
+	key, ok := list.PersonKey(person)
+	if !ok {
+		return MonthSummary{}, product.ErrPersonNotFound
+	}
+	income, _ := list.IncomeOf(key)
+
+	s := summarize(list, key, income, year, month)
+	s.Person = list.DisplayName(key)
+	return s, nil
+}
+
+func summarize(list product.ProductList, person string, monthlyProfit float64, year, month int) MonthSummary {
+	active, _ := list.ActiveInMonth(year, month)
+
+	var activeProducts, shares []product.Product
+	var totalParcel float64
+	for _, p := range active {
+		ratio := p.ShareOf(person)
+		if ratio <= 0 {
+			continue
+		}
+		share := p
+		share.Parcel = p.Parcel * ratio
+		activeProducts = append(activeProducts, p)
+		shares = append(shares, share)
+		totalParcel += share.Parcel
+	}
+
+	s := MonthSummary{
+		Year:           year,
+		Month:          month,
+		MonthlyProfit:  monthlyProfit,
+		TotalParcel:    totalParcel,
+		SafePercentage: list.SafePercentage,
+		Products:       shareLines(activeProducts, person, year, month),
+		Suggested:      []ProductLine{},
+	}
+	s.computeBudget()
+
+	if s.Verdict == VerdictNotRecommended {
+		suggested, suggestedTotal := SuggestProducts(shares, monthlyProfit, list.SafePercentage)
+		var originals []product.Product
+		for _, p := range suggested {
+			if idx, ok := list.FindByID(p.ID); ok {
+				originals = append(originals, list.Products[idx])
+			}
+		}
+		s.Suggested = shareLines(originals, person, year, month)
+		s.SuggestedTotal = suggestedTotal
+	}
+
+	return s
+}
+
+func Combine(year, month int, summaries ...MonthSummary) MonthSummary {
+	s := MonthSummary{
+		Year:      year,
+		Month:     month,
+		Products:  []ProductLine{},
+		Suggested: []ProductLine{},
+	}
+
+	var safeValue float64
+	for _, part := range summaries {
+		s.MonthlyProfit += part.MonthlyProfit
+		s.TotalParcel += part.TotalParcel
+		s.Products = append(s.Products, part.Products...)
+		s.Suggested = append(s.Suggested, part.Suggested...)
+		s.SuggestedTotal += part.SuggestedTotal
+		safeValue += part.MonthlyProfit * part.SafePercentage / 100
+	}
+
+	if s.MonthlyProfit > 0 {
+		s.SafePercentage = (safeValue / s.MonthlyProfit) * 100
+	}
+	s.computeBudget()
+
+	return s
+}
+
+func (s *MonthSummary) computeBudget() {
+	s.UsedPercent = 0
+	s.LeftPercent = 100
+	s.ReinvestValue = 0
+
+	if s.MonthlyProfit > 0 {
+		s.UsedPercent = (s.TotalParcel / s.MonthlyProfit) * 100
+		s.LeftPercent = 100 - s.UsedPercent
+		s.ReinvestValue = (s.LeftPercent / 100) * s.MonthlyProfit
+	}
+
+	s.SpendablePercent = 100.0 - s.SafePercentage
+	s.SpendableValue = (s.SpendablePercent / 100) * s.MonthlyProfit
+	s.RemainingSpendable = s.SpendableValue - s.TotalParcel
+	if s.RemainingSpendable < 0 {
+		s.RemainingSpendable = 0
+	}
+
+	s.Verdict = VerdictOK
+	if s.LeftPercent < s.SafePercentage {
+		s.Verdict = VerdictNotRecommended
+	}
+}
+
+func ActiveProducts(list product.ProductList, year, month int) []ProductLine {
+	activeProducts, _ := list.ActiveInMonth(year, month)
+	return productLines(activeProducts, year, month)
+}
+
+func SuggestProducts(products []product.Product, monthlyProfit float64, safePercentage float64) ([]product.Product, float64) {
+	if len(products) == 0 {
+		return nil, 0
+	}
+
+	sorted := make([]product.Product, len(products))
+	copy(sorted, products)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Parcel > sorted[j].Parcel
+	})
+
+	var totalParcel float64
+	for _, p := range products {
+		totalParcel += p.Parcel
+	}
+
+	targetParcel := totalParcel - (monthlyProfit * (safePercentage / 100))
+	if targetParcel <= 0 {
+		return nil, 0
+	}
+
+	var suggestedProducts []product.Product
+	var suggestedParcelSum float64
+
+	for _, p := range sorted {
+		if suggestedParcelSum >= targetParcel {
+			break
+		}
+		suggestedProducts = append(suggestedProducts, p)
+		suggestedParcelSum += p.Parcel
+	}
+
+	return suggestedProducts, suggestedParcelSum
+}
+
+func shareLines(products []product.Product, person string, year, month int) []ProductLine {
+	lines := productLines(products, year, month)
+	for i, p := range products {
+		if p.IsShared() {
+			lines[i].Share = p.Parcel * p.ShareOf(person)
+		}
+	}
+	return lines
+}
+
+func productLines(products []product.Product, year, month int) []ProductLine {
+	lines := make([]ProductLine, 0, len(products))
+	for _, p := range products {
+		lines = append(lines, ProductLine{
+			ID:                p.ID,
+			Name:              p.Name,
+			Category:          p.Category,
+			Card:              p.Card,
+			TotalValue:        p.TotalValue,
+			Parcel:            p.Parcel,
+			InstallmentNumber: p.InstallmentNumber(year, month),
+			Installments:      p.Installments,
+			CreatedAt:         p.CreatedAt,
+			Currency:          p.Currency,
+			OriginalValue:     p.OriginalValue,
+			Paid:              p.IsPaid(p.InstallmentNumber(year, month)),
+			PaidBy:            p.PaidBy,
+		})
+	}
+	return lines
+}
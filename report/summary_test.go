@@ -0,0 +1,130 @@
+package report
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
+
+func productList(t *testing.T, monthlyProfit, safePercentage float64, parcels ...float64) product.ProductList {
+	t.Helper()
+	list := product.ProductList{MonthlyProfit: monthlyProfit, SafePercentage: safePercentage}
+	for i, parcel := range parcels {
+		p, err := product.New(string(rune('A'+i))+" produto", parcel*3, 3, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := list.Add(p, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return list
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name           string
+		monthlyProfit  float64
+		safePercentage float64
+		parcels        []float64
+		month          time.Month
+		used           float64
+		reinvest       float64
+		remaining      float64
+		verdict        Verdict
+		suggested      []string
+		suggestedTotal float64
+	}{
+		{name: "sem compras", monthlyProfit: 5000, safePercentage: 70, month: time.March, reinvest: 5000, remaining: 1500, verdict: VerdictOK},
+		{name: "dentro do limite", monthlyProfit: 5000, safePercentage: 70, parcels: []float64{1000}, month: time.March, used: 20, reinvest: 4000, remaining: 500, verdict: VerdictOK},
+		{name: "no limite", monthlyProfit: 1000, safePercentage: 70, parcels: []float64{300}, month: time.March, used: 30, reinvest: 700, verdict: VerdictOK},
+		{name: "acima do limite", monthlyProfit: 1000, safePercentage: 70, parcels: []float64{200, 800}, month: time.March, used: 100, verdict: VerdictNotRecommended, suggested: []string{"B produto"}, suggestedTotal: 800},
+		{name: "depois da última parcela", monthlyProfit: 1000, safePercentage: 70, parcels: []float64{800}, month: time.June, reinvest: 1000, remaining: 300, verdict: VerdictOK},
+		{name: "sem lucro", safePercentage: 70, parcels: []float64{100}, month: time.March, verdict: VerdictOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := productList(t, tt.monthlyProfit, tt.safePercentage, tt.parcels...)
+			s := Summarize(list, 2026, int(tt.month))
+
+			if !almostEqual(s.UsedPercent, tt.used) || !almostEqual(s.LeftPercent, 100-tt.used) {
+				t.Errorf("usado %.2f%% e livre %.2f%%, esperado %.2f%% e %.2f%%", s.UsedPercent, s.LeftPercent, tt.used, 100-tt.used)
+			}
+			if !almostEqual(s.ReinvestValue, tt.reinvest) || !almostEqual(s.RemainingSpendable, tt.remaining) {
+				t.Errorf("reinvestir %.2f e sobra %.2f, esperado %.2f e %.2f", s.ReinvestValue, s.RemainingSpendable, tt.reinvest, tt.remaining)
+			}
+			if s.Verdict != tt.verdict {
+				t.Errorf("veredito = %s, esperado %s", s.Verdict, tt.verdict)
+			}
+			if len(s.Suggested) != len(tt.suggested) || !almostEqual(s.SuggestedTotal, tt.suggestedTotal) {
+				t.Fatalf("sugestões = %+v (%.2f), esperado %v (%.2f)", s.Suggested, s.SuggestedTotal, tt.suggested, tt.suggestedTotal)
+			}
+			for i, name := range tt.suggested {
+				if s.Suggested[i].Name != name {
+					t.Errorf("sugestão %d = %s, esperado %s", i, s.Suggested[i].Name, name)
+				}
+			}
+		})
+	}
+}
+
+func TestSummarizePerson(t *testing.T) {
+	list := sharedList(t, "")
+	addShared(t, &list, "Notebook", 1000, 10, "Ana", product.Share{Person: "", Percent: 30})
+
+	tests := []struct {
+		name    string
+		person  string
+		income  float64
+		parcel  float64
+		label   string
+		wantErr error
+	}{
+		{name: "titular", person: "", income: 5000, parcel: 30},
+		{name: "outra pessoa", person: "ana", income: 4000, parcel: 70, label: "Ana"},
+		{name: "pessoa desconhecida", person: "Bia", wantErr: product.ErrPersonNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := SummarizePerson(list, tt.person, 2026, int(time.March))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("erro = %v, esperado %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if s.Person != tt.label || s.MonthlyProfit != tt.income || !almostEqual(s.TotalParcel, tt.parcel) {
+				t.Errorf("pessoa %q, renda %.2f, parcelas %.2f; esperado %q, %.2f, %.2f", s.Person, s.MonthlyProfit, s.TotalParcel, tt.label, tt.income, tt.parcel)
+			}
+			if len(s.Products) != 1 || !almostEqual(s.Products[0].Share, tt.parcel) || s.Products[0].Parcel != 100 {
+				t.Errorf("produtos = %+v, esperado parcela 100 com parte %.2f", s.Products, tt.parcel)
+			}
+		})
+	}
+}
+
+func TestCombine(t *testing.T) {
+	first := Summarize(productList(t, 5000, 70, 1000), 2026, int(time.March))
+	second := Summarize(productList(t, 3000, 50, 600), 2026, int(time.March))
+
+	s := Combine(2026, int(time.March), first, second)
+	if s.MonthlyProfit != 8000 || s.TotalParcel != 1600 || len(s.Products) != 2 {
+		t.Errorf("lucro %.2f, parcelas %.2f e %d produtos; esperado 8000, 1600 e 2", s.MonthlyProfit, s.TotalParcel, len(s.Products))
+	}
+	if !almostEqual(s.SafePercentage, 62.5) || !almostEqual(s.UsedPercent, 20) || s.Verdict != VerdictOK {
+		t.Errorf("segura %.2f%%, usado %.2f%%, veredito %s; esperado 62,50%%, 20%% e ok", s.SafePercentage, s.UsedPercent, s.Verdict)
+	}
+
+	if empty := Combine(2026, int(time.March)); empty.SafePercentage != 0 || empty.LeftPercent != 100 || empty.Products == nil {
+		t.Errorf("resumo vazio inesperado: %+v", empty)
+	}
+}
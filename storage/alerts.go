@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pedrorcruzz/smart-spending-checker/alert"
+)
+
+const alertsExt = ".alerts"
+
+func alertsPath(profile string) string {
+	return strings.TrimSuffix(profilePath(profile), ".json") + alertsExt
+}
+
+func LoadRules(profile string) ([]alert.Rule, error) {
+	data, err := os.ReadFile(alertsPath(profile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = decodeData(profile, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []alert.Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func SaveRules(profile string, rules []alert.Rule) error {
+	if err := ensureDataDir(); err != nil {
+		return err
+	}
+
+	staged, err := stageRules(profile, rules)
+	if err != nil {
+		return err
+	}
+	defer staged.discard()
+	return staged.commit()
+}
+
+func stageRules(profile string, rules []alert.Rule) (stagedFile, error) {
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return stagedFile{}, err
+	}
+
+	data, err = encodeData(profile, data, 0)
+	if err != nil {
+		return stagedFile{}, err
+	}
+	return stageFile(alertsPath(profile), data)
+}
@@ -0,0 +1,335 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	kdfName = "scrypt"
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+	keySize = 32
+
+	headerVersion = 1
+)
+
+var ErrPassphraseRequired = errors.New("perfil criptografado: senha necessária")
+var ErrWrongPassphrase = errors.New("senha incorreta")
+var ErrNotEncrypted = errors.New("perfil não está criptografado")
+var ErrAlreadyEncrypted = errors.New("perfil já está criptografado")
+var ErrEmptyPassphrase = errors.New("a senha não pode ser vazia")
+
+type encryptionParams struct {
+	KDF  string `json:"kdf"`
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+	Salt []byte `json:"salt"`
+}
+
+type encryptedFile struct {
+	Version    int               `json:"version,omitempty"`
+	Encryption *encryptionParams `json:"encryption"`
+	Revision   int               `json:"revision"`
+	Nonce      []byte            `json:"nonce"`
+	Ciphertext []byte            `json:"ciphertext"`
+}
+
+type sessionKey struct {
+	params encryptionParams
+	key    []byte
+}
+
+var (
+	keysMu sync.Mutex
+	keys   = make(map[string]sessionKey)
+)
+
+func IsEncrypted(profile string) (bool, error) {
+	data, err := readProfile(profile)
+	if err != nil {
+		return false, err
+	}
+	_, ok := parseEncrypted(data)
+	return ok, nil
+}
+
+func IsUnlocked(profile string) bool {
+	_, ok := profileKey(profile)
+	return ok
+}
+
+func Unlock(profile, passphrase string) error {
+	data, err := readProfile(profile)
+	if err != nil {
+		return err
+	}
+
+	file, ok := parseEncrypted(data)
+	if !ok {
+		return nil
+	}
+
+	key, err := deriveKey(passphrase, *file.Encryption)
+	if err != nil {
+		return err
+	}
+
+	sk := sessionKey{params: *file.Encryption, key: key}
+	if _, err := decrypt(sk, file); err != nil {
+		return ErrWrongPassphrase
+	}
+
+	setProfileKey(profile, &sk)
+	return nil
+}
+
+func Lock(profile string) {
+	setProfileKey(profile, nil)
+}
+
+func EnableEncryption(profile string, list *product.ProductList, passphrase string) error {
+	encrypted, err := IsEncrypted(profile)
+	if err != nil {
+		return err
+	}
+	if encrypted {
+		return ErrAlreadyEncrypted
+	}
+	return replaceKey(profile, list, passphrase)
+}
+
+func ChangePassphrase(profile string, list *product.ProductList, passphrase string) error {
+	encrypted, err := IsEncrypted(profile)
+	if err != nil {
+		return err
+	}
+	if !encrypted {
+		return ErrNotEncrypted
+	}
+	return replaceKey(profile, list, passphrase)
+}
+
+func DisableEncryption(profile string, list *product.ProductList) error {
+	encrypted, err := IsEncrypted(profile)
+	if err != nil {
+		return err
+	}
+	if !encrypted {
+		return ErrNotEncrypted
+	}
+	if !IsUnlocked(profile) {
+		return ErrPassphraseRequired
+	}
+
+	return switchKey(profile, nil, func() error {
+		return saveProducts(profile, list, true)
+	})
+}
+
+func replaceKey(profile string, list *product.ProductList, passphrase string) error {
+	if passphrase == "" {
+		return ErrEmptyPassphrase
+	}
+
+	sk, err := newSessionKey(passphrase)
+	if err != nil {
+		return err
+	}
+
+	return switchKey(profile, &sk, func() error {
+		return SaveProducts(profile, list)
+	})
+}
+
+func switchKey(profile string, sk *sessionKey, save func() error) error {
+	rules, err := LoadRules(profile)
+	if err != nil {
+		return err
+	}
+
+	previous, hadKey := profileKey(profile)
+	setProfileKey(profile, sk)
+	restore := func() {
+		if hadKey {
+			setProfileKey(profile, &previous)
+		} else {
+			setProfileKey(profile, nil)
+		}
+	}
+
+	var staged stagedFile
+	if rules != nil {
+		if staged, err = stageRules(profile, rules); err != nil {
+			restore()
+			return err
+		}
+		defer staged.discard()
+	}
+
+	if err := save(); err != nil {
+		restore()
+		return err
+	}
+	return staged.commit()
+}
+
+func profileKey(profile string) (sessionKey, bool) {
+	keysMu.Lock()
+	defer keysMu.Unlock()
+	sk, ok := keys[profilePath(profile)]
+	return sk, ok
+}
+
+func setProfileKey(profile string, sk *sessionKey) {
+	keysMu.Lock()
+	defer keysMu.Unlock()
+	if sk == nil {
+		delete(keys, profilePath(profile))
+		return
+	}
+	keys[profilePath(profile)] = *sk
+}
+
+func newSessionKey(passphrase string) (sessionKey, error) {
+	params := encryptionParams{KDF: kdfName, N: scryptN, R: scryptR, P: scryptP, Salt: make([]byte, 16)}
+	if _, err := rand.Read(params.Salt); err != nil {
+		return sessionKey{}, err
+	}
+
+	key, err := deriveKey(passphrase, params)
+	if err != nil {
+		return sessionKey{}, err
+	}
+	return sessionKey{params: params, key: key}, nil
+}
+
+func deriveKey(passphrase string, params encryptionParams) ([]byte, error) {
+	if params.KDF != kdfName {
+		return nil, errors.New("algoritmo de derivação de chave desconhecido: " + params.KDF)
+	}
+	return scrypt.Key([]byte(passphrase), params.Salt, params.N, params.R, params.P, keySize)
+}
+
+func parseEncrypted(data []byte) (encryptedFile, bool) {
+	var file encryptedFile
+	if len(data) == 0 || json.Unmarshal(data, &file) != nil || file.Encryption == nil {
+		return file, false
+	}
+	return file, true
+}
+
+func encrypt(sk sessionKey, plaintext []byte, revision int) ([]byte, error) {
+	params := sk.params
+	file := encryptedFile{
+		Version:    headerVersion,
+		Encryption: &params,
+		Revision:   revision,
+	}
+
+	nonce, ciphertext, err := seal(sk, plaintext, headerData(file))
+	if err != nil {
+		return nil, err
+	}
+	file.Nonce = nonce
+	file.Ciphertext = ciphertext
+	return json.MarshalIndent(file, "", "  ")
+}
+
+func decrypt(sk sessionKey, file encryptedFile) ([]byte, error) {
+	if file.Version == 0 {
+		return unseal(sk, file.Nonce, file.Ciphertext, nil)
+	}
+	return unseal(sk, file.Nonce, file.Ciphertext, headerData(file))
+}
+
+func headerData(file encryptedFile) []byte {
+	file.Nonce = nil
+	file.Ciphertext = nil
+	data, _ := json.Marshal(file)
+	return data
+}
+
+func lineData(sk sessionKey, seq int) []byte {
+	params := sk.params
+	data := headerData(encryptedFile{Version: headerVersion, Encryption: &params})
+	return strconv.AppendInt(append(data, '#'), int64(seq), 10)
+}
+
+func seal(sk sessionKey, plaintext, additionalData []byte) ([]byte, []byte, error) {
+	gcm, err := newGCM(sk.key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return nonce, gcm.Seal(nil, nonce, plaintext, additionalData), nil
+}
+
+func unseal(sk sessionKey, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(sk.key)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func decodeData(profile string, data []byte) ([]byte, error) {
+	file, ok := parseEncrypted(data)
+	if !ok {
+		return data, nil
+	}
+
+	sk, unlocked := profileKey(profile)
+	if !unlocked {
+		return nil, ErrPassphraseRequired
+	}
+	if !bytes.Equal(sk.params.Salt, file.Encryption.Salt) {
+		return nil, ErrWrongPassphrase
+	}
+
+	plaintext, err := decrypt(sk, file)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	return plaintext, nil
+}
+
+func encodeData(profile string, plaintext []byte, revision int) ([]byte, error) {
+	sk, ok := profileKey(profile)
+	if !ok {
+		return plaintext, nil
+	}
+	return encrypt(sk, plaintext, revision)
+}
+
+func sameFormat(profile string, data []byte) bool {
+	file, encrypted := parseEncrypted(data)
+	sk, unlocked := profileKey(profile)
+	if encrypted != unlocked {
+		return false
+	}
+	return !encrypted || (file.Version == headerVersion && bytes.Equal(file.Encryption.Salt, sk.params.Salt))
+}
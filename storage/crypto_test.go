@@ -0,0 +1,247 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pedrorcruzz/smart-spending-checker/alert"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+const passphrase = "segredo"
+
+var testRules = []alert.Rule{{Kind: alert.KindUsedPercent, Threshold: 80, Months: 3}}
+
+func encryptedProfile(t *testing.T) product.ProductList {
+	t.Helper()
+	useTempDataDir(t)
+	t.Cleanup(func() { Lock("") })
+
+	list, err := LoadProducts("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addProduct(t, &list, "Notebook")
+	addProduct(t, &list, "Fone")
+	if err := SaveProducts("", &list); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveRules("", testRules); err != nil {
+		t.Fatal(err)
+	}
+	if err := EnableEncryption("", &list, passphrase); err != nil {
+		t.Fatal(err)
+	}
+	Lock("")
+	return list
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	encryptedProfile(t)
+
+	for _, path := range []string{profilePath(""), journalPath(""), alertsPath("")} {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(data, []byte("Notebook")) || bytes.Contains(data, []byte("used_percent")) {
+			t.Errorf("%s tem dados em texto puro:\n%s", filepath.Base(path), data)
+		}
+	}
+
+	if _, err := LoadProducts(""); !errors.Is(err, ErrPassphraseRequired) {
+		t.Errorf("erro sem senha = %v, esperado %v", err, ErrPassphraseRequired)
+	}
+	if err := Unlock("", passphrase); err != nil {
+		t.Fatal(err)
+	}
+	list, err := LoadProducts("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Products) != 2 || len(list.Events) != 2 {
+		t.Errorf("%d produtos e %d eventos, esperado 2 e 2", len(list.Products), len(list.Events))
+	}
+	rules, err := LoadRules("")
+	if err != nil || len(rules) != 1 || rules[0] != testRules[0] {
+		t.Errorf("regras = %+v, %v", rules, err)
+	}
+
+	if err := DisableEncryption("", &list); err != nil {
+		t.Fatal(err)
+	}
+	Lock("")
+	if list, err = LoadProducts(""); err != nil || len(list.Products) != 2 {
+		t.Errorf("depois de desativar: %d produtos, %v", len(list.Products), err)
+	}
+	if rules, err = LoadRules(""); err != nil || len(rules) != 1 {
+		t.Errorf("regras depois de desativar = %+v, %v", rules, err)
+	}
+}
+
+func TestWrongPassphrase(t *testing.T) {
+	encryptedProfile(t)
+
+	if err := Unlock("", "errada"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("erro = %v, esperado %v", err, ErrWrongPassphrase)
+	}
+	if IsUnlocked("") {
+		t.Error("o perfil não deveria ficar desbloqueado com a senha errada")
+	}
+}
+
+func TestTamperedHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(file map[string]any)
+	}{
+		{name: "revisão", tamper: func(file map[string]any) { file["revision"] = file["revision"].(float64) + 1 }},
+		{name: "versão removida", tamper: func(file map[string]any) { delete(file, "version") }},
+		{name: "parâmetros", tamper: func(file map[string]any) { file["encryption"].(map[string]any)["n"] = 16384.0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encryptedProfile(t)
+
+			data, err := os.ReadFile(profilePath(""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var file map[string]any
+			if err := json.Unmarshal(data, &file); err != nil {
+				t.Fatal(err)
+			}
+			tt.tamper(file)
+			if data, err = json.Marshal(file); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(profilePath(""), data, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := Unlock("", passphrase); !errors.Is(err, ErrWrongPassphrase) {
+				t.Errorf("erro = %v, esperado %v", err, ErrWrongPassphrase)
+			}
+		})
+	}
+}
+
+func TestTamperedJournal(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(lines []string) []string
+	}{
+		{name: "linha removida", tamper: func(lines []string) []string { return lines[1:] }},
+		{name: "última linha removida", tamper: func(lines []string) []string { return lines[:1] }},
+		{name: "linhas trocadas", tamper: func(lines []string) []string { return []string{lines[1], lines[0]} }},
+		{name: "linha repetida", tamper: func(lines []string) []string { return []string{lines[0], lines[0], lines[1]} }},
+		{name: "seq alterado", tamper: func(lines []string) []string {
+			return []string{lines[0], strings.Replace(lines[1], `"seq":2`, `"seq":3`, 1)}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encryptedProfile(t)
+
+			data, err := os.ReadFile(journalPath(""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			if len(lines) != 2 {
+				t.Fatalf("%d linhas no histórico, esperado 2", len(lines))
+			}
+			tampered := strings.Join(tt.tamper(lines), "\n") + "\n"
+			if err := os.WriteFile(journalPath(""), []byte(tampered), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := Unlock("", passphrase); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadProducts(""); !errors.Is(err, ErrJournalTampered) {
+				t.Errorf("erro = %v, esperado %v", err, ErrJournalTampered)
+			}
+		})
+	}
+}
+
+func TestLegacyEncryptedFile(t *testing.T) {
+	list := encryptedProfile(t)
+	if err := Unlock("", passphrase); err != nil {
+		t.Fatal(err)
+	}
+	sk, _ := profileKey("")
+
+	plaintext, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, ciphertext, err := seal(sk, plaintext, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := sk.params
+	legacy, err := json.Marshal(encryptedFile{Encryption: &params, Revision: list.Revision, Nonce: nonce, Ciphertext: ciphertext})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(profilePath(""), legacy, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	Lock("")
+	if err := Unlock("", passphrase); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadProducts("")
+	if err != nil || len(loaded.Products) != 2 {
+		t.Fatalf("arquivo antigo: %d produtos, %v", len(loaded.Products), err)
+	}
+
+	if err := SaveProducts("", &loaded); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(profilePath(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file, _ := parseEncrypted(data); file.Version != headerVersion {
+		t.Errorf("versão depois de salvar = %d, esperado %d", file.Version, headerVersion)
+	}
+}
+
+func TestSwitchKeyFailureKeepsRulesReadable(t *testing.T) {
+	encryptedProfile(t)
+	if err := Unlock("", passphrase); err != nil {
+		t.Fatal(err)
+	}
+
+	sk, err := newSessionKey("outra")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := switchKey("", &sk, func() error { return os.ErrPermission }); !errors.Is(err, os.ErrPermission) {
+		t.Fatalf("erro = %v, esperado %v", err, os.ErrPermission)
+	}
+
+	if rules, err := LoadRules(""); err != nil || len(rules) != 1 {
+		t.Errorf("regras depois da falha = %+v, %v", rules, err)
+	}
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if strings.Contains(entry.Name(), ".tmp") {
+			t.Errorf("arquivo temporário esquecido: %s", entry.Name())
+		}
+	}
+}
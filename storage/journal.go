@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+const journalExt = ".journal"
+
+var ErrJournalTampered = errors.New("histórico de alterações adulterado ou corrompido")
+
+type encryptedLine struct {
+	Seq        int    `json:"seq,omitempty"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func journalPath(profile string) string {
+	return strings.TrimSuffix(profilePath(profile), ".json") + journalExt
+}
+
+func readJournal(profile string) ([]product.Event, error) {
+	file, err := os.Open(journalPath(profile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var events []product.Event
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		e, err := decodeJournalLine(profile, line)
+		if err != nil {
+			return events, err
+		}
+		if _, encrypted := profileKey(profile); encrypted && e.Seq != len(events)+1 {
+			return events, ErrJournalTampered
+		}
+		events = append(events, e)
+	}
+	return events, scanner.Err()
+}
+
+func journalSize(profile string) int64 {
+	info, err := os.Stat(journalPath(profile))
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+func appendJournal(profile string, events []product.Event) error {
+	data, err := encodeJournal(profile, events)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(journalPath(profile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func stageJournal(profile string, events []product.Event) (stagedFile, error) {
+	data, err := encodeJournal(profile, events)
+	if err != nil {
+		return stagedFile{}, err
+	}
+	return stageFile(journalPath(profile), data)
+}
+
+func encodeJournal(profile string, events []product.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	sk, encrypted := profileKey(profile)
+
+	for _, e := range events {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+
+		if encrypted {
+			nonce, ciphertext, err := seal(sk, line, lineData(sk, e.Seq))
+			if err != nil {
+				return nil, err
+			}
+			line, err = json.Marshal(encryptedLine{Seq: e.Seq, Nonce: nonce, Ciphertext: ciphertext})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeJournalLine(profile string, line []byte) (product.Event, error) {
+	var e product.Event
+
+	var enc encryptedLine
+	if err := json.Unmarshal(line, &enc); err == nil && len(enc.Ciphertext) > 0 {
+		sk, unlocked := profileKey(profile)
+		if !unlocked {
+			return e, ErrPassphraseRequired
+		}
+		var additionalData []byte
+		if enc.Seq != 0 {
+			additionalData = lineData(sk, enc.Seq)
+		}
+		plaintext, err := unseal(sk, enc.Nonce, enc.Ciphertext, additionalData)
+		if err != nil {
+			return e, ErrJournalTampered
+		}
+		if err := json.Unmarshal(plaintext, &e); err != nil {
+			return e, err
+		}
+		if enc.Seq != 0 && e.Seq != enc.Seq {
+			return e, ErrJournalTampered
+		}
+		return e, nil
+	}
+
+	err := json.Unmarshal(line, &e)
+	return e, err
+}
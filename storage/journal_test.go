@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+)
+
+var now = time.Date(2026, time.March, 15, 12, 0, 0, 0, time.Local)
+
+func useTempDataDir(t *testing.T) {
+	t.Helper()
+	previous := dataDir
+	SetDataDir(t.TempDir())
+	t.Cleanup(func() { SetDataDir(previous) })
+}
+
+func addProduct(t *testing.T, list *product.ProductList, name string) {
+	t.Helper()
+	p, err := product.New(name, 300, 3, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := list.Add(p, now); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func saveAndReload(t *testing.T, profile string, list *product.ProductList) product.ProductList {
+	t.Helper()
+	if err := SaveProducts(profile, list); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := LoadProducts(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reloaded
+}
+
+func TestUndoRedoAcrossRestarts(t *testing.T) {
+	useTempDataDir(t)
+
+	list, err := LoadProducts("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addProduct(t, &list, "Notebook")
+	addProduct(t, &list, "Fone")
+	list = saveAndReload(t, "", &list)
+
+	if len(list.Events) != 2 || len(list.PendingEvents()) != 0 {
+		t.Fatalf("%d eventos (%d pendentes), esperado 2 (0)", len(list.Events), len(list.PendingEvents()))
+	}
+	if _, err := list.Undo(now); err != nil {
+		t.Fatal(err)
+	}
+	list = saveAndReload(t, "", &list)
+
+	if len(list.Products) != 1 || list.Products[0].Name != "Notebook" {
+		t.Fatalf("produtos depois de desfazer: %+v", list.Products)
+	}
+	next, ok := list.NextRedo()
+	if !ok || next.After == nil || next.After.Name != "Fone" {
+		t.Fatalf("próximo refazer = %+v, %v", next, ok)
+	}
+
+	if _, err := list.Redo(now); err != nil {
+		t.Fatal(err)
+	}
+	list = saveAndReload(t, "", &list)
+	if len(list.Products) != 2 {
+		t.Fatalf("produtos depois de refazer: %+v", list.Products)
+	}
+	if _, ok := list.NextRedo(); ok {
+		t.Error("nada deveria sobrar para refazer")
+	}
+	if next, ok := list.NextUndo(); !ok || next.After.Name != "Fone" {
+		t.Errorf("próximo desfazer = %+v, %v", next, ok)
+	}
+}
+
+func TestLoadReplaysJournalPastSnapshot(t *testing.T) {
+	useTempDataDir(t)
+
+	list, err := LoadProducts("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addProduct(t, &list, "Notebook")
+	if err := SaveProducts("", &list); err != nil {
+		t.Fatal(err)
+	}
+
+	addProduct(t, &list, "Fone")
+	if err := appendJournal("", list.PendingEvents()); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := LoadProducts("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.Products) != 2 || reloaded.JournalSeq != 2 {
+		t.Errorf("produtos %+v e seq %d, esperado 2 produtos e seq 2", reloaded.Products, reloaded.JournalSeq)
+	}
+}
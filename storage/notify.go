@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pedrorcruzz/smart-spending-checker/notify"
+)
+
+const notifyFile = "notify.json"
+
+func LoadNotifyConfig() (notify.Config, error) {
+	var config notify.Config
+
+	data, err := os.ReadFile(filepath.Join(dataDir, notifyFile))
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return config, err
+	}
+
+	err = json.Unmarshal(data, &config)
+	return config, err
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const DefaultProfile = "principal"
+
+const profilesDir = "profiles"
+const configFile = "config.json"
+
+var ErrInvalidProfileName = errors.New("nome de perfil inválido")
+var ErrProfileExists = errors.New("perfil já existe")
+
+var profileNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+type Config struct {
+	ActiveProfile string `json:"active_profile"`
+	Language      string `json:"language,omitempty"`
+}
+
+func profilePath(profile string) string {
+	if profile == "" || profile == DefaultProfile {
+		return filepath.Join(dataDir, dataFile)
+	}
+	return filepath.Join(dataDir, profilesDir, profile+".json")
+}
+
+func LoadConfig() (Config, error) {
+	config := Config{ActiveProfile: DefaultProfile}
+
+	data, err := os.ReadFile(filepath.Join(dataDir, configFile))
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return config, err
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, err
+	}
+
+	if config.ActiveProfile == "" || !ProfileExists(config.ActiveProfile) {
+		config.ActiveProfile = DefaultProfile
+	}
+	return config, nil
+}
+
+func SaveConfig(config Config) error {
+	if err := ensureDataDir(); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dataDir, configFile), data, 0644)
+}
+
+func ListProfiles() ([]string, error) {
+	profiles := []string{DefaultProfile}
+
+	entries, err := os.ReadDir(filepath.Join(dataDir, profilesDir))
+	if os.IsNotExist(err) {
+		return profiles, nil
+	}
+	if err != nil {
+		return profiles, err
+	}
+
+	var others []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".json" {
+			continue
+		}
+		others = append(others, strings.TrimSuffix(name, ".json"))
+	}
+	sort.Strings(others)
+
+	return append(profiles, others...), nil
+}
+
+func ProfileExists(profile string) bool {
+	if profile == DefaultProfile {
+		return true
+	}
+	_, err := os.Stat(profilePath(profile))
+	return err == nil
+}
+
+func CreateProfile(profile string) error {
+	if !profileNamePattern.MatchString(profile) {
+		return ErrInvalidProfileName
+	}
+	if ProfileExists(profile) {
+		return ErrProfileExists
+	}
+
+	list, err := LoadProducts(profile)
+	if err != nil {
+		return err
+	}
+	return SaveProducts(profile, &list)
+}
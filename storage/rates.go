@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+)
+
+const ratesFile = "rates.json"
+
+func LoadRates() (money.Rates, error) {
+	rates := money.NewRates()
+
+	data, err := os.ReadFile(filepath.Join(dataDir, ratesFile))
+	if os.IsNotExist(err) {
+		return rates, nil
+	}
+	if err != nil {
+		return rates, err
+	}
+
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return rates, err
+	}
+	if rates.Rates == nil {
+		rates.Rates = map[string]float64{}
+	}
+	return rates, nil
+}
+
+func SaveRates(rates money.Rates) error {
+	if err := ensureDataDir(); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(rates, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filepath.Join(dataDir, ratesFile), data)
+}
@@ -1,24 +1,34 @@
 package storage
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 
 	"github.com/pedrorcruzz/smart-spending-checker/product"
 )
 
-const dataDir = "data"
 const dataFile = "products.json"
 
+var dataDir = "data"
+
+var ErrConflict = errors.New("os dados foram modificados por outra sessão")
+var ErrLocked = errors.New("arquivo de dados bloqueado por outra sessão")
+
+func SetDataDir(dir string) {
+	dataDir = dir
+}
+
 func ensureDataDir() error {
 	if _, err := os.Stat(dataDir); os.IsNotExist(err) {
-		return os.Mkdir(dataDir, 0755)
+		return os.MkdirAll(dataDir, 0755)
 	}
 	return nil
 }
 
-func LoadProducts() (product.ProductList, error) {
+func LoadProducts(profile string) (product.ProductList, error) {
 	var list product.ProductList
 	list.SafePercentage = 70
 
@@ -26,34 +36,190 @@ func LoadProducts() (product.ProductList, error) {
 		return list, err
 	}
 
-	filePath := filepath.Join(dataDir, dataFile)
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		return list, nil
+	data, err := readProfile(profile)
+	if err != nil || len(data) == 0 {
+		return list, err
 	}
 
-	data, err := os.ReadFile(filePath)
+	data, err = decodeData(profile, data)
 	if err != nil {
 		return list, err
 	}
 
-	if len(data) == 0 {
-		return list, nil
+	if err := json.Unmarshal(data, &list); err != nil {
+		return list, err
+	}
+	list.EnsureIDs()
+
+	events, err := readJournal(profile)
+	if err != nil {
+		return list, err
+	}
+	if _, encrypted := profileKey(profile); encrypted && len(events) < list.JournalSeq {
+		return list, ErrJournalTampered
 	}
+	list.Events = events
 
-	err = json.Unmarshal(data, &list)
+	err = list.Replay(events)
 	return list, err
 }
 
-func SaveProducts(list product.ProductList) error {
+func SaveProducts(profile string, list *product.ProductList) error {
+	return saveProducts(profile, list, false)
+}
+
+func saveProducts(profile string, list *product.ProductList, decrypting bool) error {
 	if err := ensureDataDir(); err != nil {
 		return err
 	}
 
+	filePath := profilePath(profile)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+
+	unlock, err := lockFile(filePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current, err := readProfile(profile)
+	if err != nil {
+		return err
+	}
+
+	if revision := revisionOf(current); revision != list.Revision {
+		return ErrConflict
+	}
+
+	if _, encrypted := parseEncrypted(current); encrypted && !decrypting && !IsUnlocked(profile) {
+		return ErrPassphraseRequired
+	}
+
 	data, err := json.MarshalIndent(list, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	filePath := filepath.Join(dataDir, dataFile)
-	return os.WriteFile(filePath, data, 0644)
+	pending := list.PendingEvents()
+	formatChanged := !sameFormat(profile, current)
+
+	if !formatChanged && len(pending) == 0 {
+		if plaintext, err := decodeData(profile, current); err == nil && bytes.Equal(data, plaintext) {
+			return nil
+		}
+	}
+
+	var journal stagedFile
+	appendedFrom := journalSize(profile)
+	if formatChanged {
+		journal, err = stageJournal(profile, list.Events)
+	} else if len(pending) > 0 {
+		err = appendJournal(profile, pending)
+	}
+	if err != nil {
+		return err
+	}
+	defer journal.discard()
+
+	previousSeq := list.JournalSeq
+	if n := len(list.Events); n > 0 {
+		list.JournalSeq = list.Events[n-1].Seq
+	}
+	list.Revision++
+
+	data, err = json.MarshalIndent(list, "", "  ")
+	if err == nil {
+		data, err = encodeData(profile, data, list.Revision)
+	}
+	if err == nil {
+		err = writeFileAtomic(filePath, data)
+	}
+	if err != nil {
+		if !formatChanged && len(pending) > 0 {
+			os.Truncate(journalPath(profile), appendedFrom)
+		}
+		list.Revision--
+		list.JournalSeq = previousSeq
+		return err
+	}
+	return journal.commit()
+}
+
+func CurrentRevision(profile string) (int, error) {
+	data, err := readProfile(profile)
+	if err != nil {
+		return 0, err
+	}
+	return revisionOf(data), nil
+}
+
+func readProfile(profile string) ([]byte, error) {
+	data, err := os.ReadFile(profilePath(profile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+func revisionOf(data []byte) int {
+	var header struct {
+		Revision int `json:"revision"`
+	}
+	if len(data) == 0 {
+		return 0
+	}
+	json.Unmarshal(data, &header)
+	return header.Revision
+}
+
+func writeFileAtomic(filePath string, data []byte) error {
+	staged, err := stageFile(filePath, data)
+	if err != nil {
+		return err
+	}
+	defer staged.discard()
+	return staged.commit()
+}
+
+type stagedFile struct {
+	tmp  string
+	path string
+}
+
+func stageFile(filePath string, data []byte) (stagedFile, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".tmp*")
+	if err != nil {
+		return stagedFile{}, err
+	}
+	staged := stagedFile{tmp: tmp.Name(), path: filePath}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		staged.discard()
+		return stagedFile{}, err
+	}
+	if err := tmp.Close(); err != nil {
+		staged.discard()
+		return stagedFile{}, err
+	}
+	if err := os.Chmod(staged.tmp, 0644); err != nil {
+		staged.discard()
+		return stagedFile{}, err
+	}
+	return staged, nil
+}
+
+func (f stagedFile) commit() error {
+	if f.tmp == "" {
+		return nil
+	}
+	return os.Rename(f.tmp, f.path)
+}
+
+func (f stagedFile) discard() {
+	if f.tmp != "" {
+		os.Remove(f.tmp)
+	}
 }
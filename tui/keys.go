@@ -0,0 +1,83 @@
+package tui
+
+import "unicode/utf8"
+
+type keyCode int
+
+const (
+	keyRune keyCode = iota
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyEnter
+	keyEsc
+	keyBackspace
+	keyTab
+	keyCtrlC
+)
+
+type key struct {
+	code keyCode
+	r    rune
+}
+
+var escapeKeys = map[string]keyCode{
+	"\x1b[A": keyUp,
+	"\x1b[B": keyDown,
+	"\x1b[C": keyRight,
+	"\x1b[D": keyLeft,
+	"\x1bOA": keyUp,
+	"\x1bOB": keyDown,
+	"\x1bOC": keyRight,
+	"\x1bOD": keyLeft,
+}
+
+func decodeKeys(data []byte) []key {
+	var keys []key
+
+	for len(data) > 0 {
+		if data[0] == 0x1b {
+			if len(data) >= 3 {
+				if code, ok := escapeKeys[string(data[:3])]; ok {
+					keys = append(keys, key{code: code})
+					data = data[3:]
+					continue
+				}
+			}
+			if len(data) > 1 && data[1] == '[' {
+				// Unknown CSI sequence: skip it up to its final byte.
+				end := 2
+				for end < len(data) && (data[end] < 0x40 || data[end] > 0x7e) {
+					end++
+				}
+				data = data[min(end+1, len(data)):]
+				continue
+			}
+			keys = append(keys, key{code: keyEsc})
+			data = data[1:]
+			continue
+		}
+
+		switch data[0] {
+		case '\r', '\n':
+			keys = append(keys, key{code: keyEnter})
+		case 0x7f, 0x08:
+			keys = append(keys, key{code: keyBackspace})
+		case '\t':
+			keys = append(keys, key{code: keyTab})
+		case 0x03:
+			keys = append(keys, key{code: keyCtrlC})
+		default:
+			r, size := utf8.DecodeRune(data)
+			if r >= ' ' {
+				keys = append(keys, key{code: keyRune, r: r})
+			}
+			data = data[size:]
+			continue
+		}
+		data = data[1:]
+	}
+
+	return keys
+}
@@ -0,0 +1,304 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+)
+
+type mode int
+
+const (
+	modeBrowse mode = iota
+	modeEdit
+	modeConfirmRemove
+)
+
+const (
+	fieldName = iota
+	fieldTotal
+	fieldInstallments
+	fieldCount
+)
+
+var fieldLabels = [fieldCount]string{"Nome", "Valor total", "Parcelas"}
+
+type form struct {
+	id     int
+	values [fieldCount]string
+	field  int
+}
+
+type model struct {
+	profile string
+	list    product.ProductList
+	clock   clock.Clock
+	save    func(*product.ProductList) error
+
+	month  int
+	row    int
+	mode   mode
+	form   form
+	status string
+	quit   bool
+}
+
+func newModel(opts Options) *model {
+	now := opts.Clock.Now()
+	return &model{
+		profile: opts.Profile,
+		list:    opts.List,
+		clock:   opts.Clock,
+		save:    opts.Save,
+		month:   monthIndex(now.Year(), int(now.Month())),
+	}
+}
+
+func monthIndex(year, month int) int {
+	return year*12 + month - 1
+}
+
+func yearMonth(index int) (int, int) {
+	return index / 12, index%12 + 1
+}
+
+func (m *model) summary() report.MonthSummary {
+	year, month := yearMonth(m.month)
+	return report.Summarize(m.list, year, month)
+}
+
+func (m *model) selected() (report.ProductLine, bool) {
+	lines := m.summary().Products
+	if m.row < 0 || m.row >= len(lines) {
+		return report.ProductLine{}, false
+	}
+	return lines[m.row], true
+}
+
+func (m *model) clampRow() {
+	count := len(m.summary().Products)
+	if m.row >= count {
+		m.row = count - 1
+	}
+	if m.row < 0 {
+		m.row = 0
+	}
+}
+
+func (m *model) handle(k key) {
+	if k.code == keyCtrlC {
+		m.quit = true
+		return
+	}
+
+	switch m.mode {
+	case modeEdit:
+		m.handleEdit(k)
+	case modeConfirmRemove:
+		m.handleConfirmRemove(k)
+	default:
+		m.handleBrowse(k)
+	}
+}
+
+func (m *model) handleBrowse(k key) {
+	m.status = ""
+
+	switch k.code {
+	case keyLeft:
+		m.month--
+		m.clampRow()
+	case keyRight:
+		m.month++
+		m.clampRow()
+	case keyUp:
+		if m.row > 0 {
+			m.row--
+		}
+	case keyDown:
+		if m.row < len(m.summary().Products)-1 {
+			m.row++
+		}
+	case keyEnter:
+		m.startEdit()
+	case keyRune:
+		switch k.r {
+		case 'q':
+			m.quit = true
+		case 'h':
+			now := m.clock.Now()
+			m.month = monthIndex(now.Year(), int(now.Month()))
+			m.clampRow()
+		case 'a':
+			m.mode = modeEdit
+			m.form = form{}
+		case 'e':
+			m.startEdit()
+		case 'd':
+			if _, ok := m.selected(); ok {
+				m.mode = modeConfirmRemove
+			}
+		case 'u':
+			m.undoRedo(m.list.Undo, i18n.T("Desfeito."))
+		case 'r':
+			m.undoRedo(m.list.Redo, i18n.T("Refeito."))
+		}
+	}
+}
+
+func (m *model) startEdit() {
+	line, ok := m.selected()
+	if !ok {
+		return
+	}
+
+	m.mode = modeEdit
+	m.form = form{id: line.ID}
+	m.form.values[fieldName] = line.Name
+	m.form.values[fieldTotal] = money.FormatNumber(line.TotalValue)
+	if line.Currency != "" {
+		m.form.values[fieldTotal] = money.FormatNumber(line.OriginalValue)
+	}
+	m.form.values[fieldInstallments] = strconv.Itoa(line.Installments)
+}
+
+func (m *model) handleEdit(k key) {
+	f := &m.form
+
+	switch k.code {
+	case keyEsc:
+		m.mode = modeBrowse
+		m.status = ""
+	case keyTab, keyDown:
+		f.field = (f.field + 1) % fieldCount
+	case keyUp:
+		f.field = (f.field + fieldCount - 1) % fieldCount
+	case keyBackspace:
+		value := []rune(f.values[f.field])
+		if len(value) > 0 {
+			f.values[f.field] = string(value[:len(value)-1])
+		}
+	case keyRune:
+		f.values[f.field] += string(k.r)
+	case keyEnter:
+		if f.field < fieldCount-1 {
+			f.field++
+			return
+		}
+		m.submit()
+	}
+}
+
+func (m *model) submit() {
+	f := m.form
+	name := strings.TrimSpace(f.values[fieldName])
+	if err := product.ValidateName(name); err != nil {
+		m.fail(fieldName, err)
+		return
+	}
+	total, err := money.Parse(strings.TrimSpace(f.values[fieldTotal]))
+	if err != nil || total <= 0 {
+		m.fail(fieldTotal, product.ErrInvalidValue)
+		return
+	}
+	installments, err := strconv.Atoi(strings.TrimSpace(f.values[fieldInstallments]))
+	if err != nil || installments < 1 {
+		m.fail(fieldInstallments, product.ErrInvalidInstallments)
+		return
+	}
+
+	now := m.clock.Now()
+	if f.id == 0 {
+		p, err := product.New(name, total, installments, now)
+		if err == nil {
+			p, err = m.list.Add(p, clock.RealNow(m.clock))
+		}
+		if err != nil {
+			m.status = i18n.T("Erro: ") + i18n.Error(err)
+			return
+		}
+		m.list.Month = int(now.Month())
+		m.list.Year = now.Year()
+		m.month = monthIndex(p.InstallmentMonth(1))
+		m.selectID(p.ID)
+		m.commit(i18n.Sprintf("✅ Produto '%s' adicionado! Parcela mensal: %s", p.Name, money.Format(p.Parcel)))
+		return
+	}
+
+	idx, ok := m.list.FindByID(f.id)
+	if !ok {
+		m.mode = modeBrowse
+		m.status = i18n.T("Erro: ") + i18n.Error(product.ErrProductNotFound)
+		return
+	}
+	p := m.list.Products[idx]
+	p.Name = name
+	p.Installments = installments
+	p.SetAmount(total)
+	if err := m.list.Update(p, clock.RealNow(m.clock)); err != nil {
+		m.status = i18n.T("Erro: ") + i18n.Error(err)
+		return
+	}
+	m.commit(i18n.T("✅ Produto atualizado!"))
+}
+
+func (m *model) fail(field int, err error) {
+	m.form.field = field
+	m.status = i18n.T("Erro: ") + i18n.Error(err)
+}
+
+func (m *model) handleConfirmRemove(k key) {
+	m.mode = modeBrowse
+	if k.code != keyRune || !i18n.IsYes(string(k.r)) {
+		m.status = i18n.T("Remoção cancelada.")
+		return
+	}
+
+	line, ok := m.selected()
+	if !ok {
+		return
+	}
+	if err := m.list.Remove(line.ID, clock.RealNow(m.clock)); err != nil {
+		m.status = i18n.T("Erro: ") + i18n.Error(err)
+		return
+	}
+	m.clampRow()
+	m.commit(i18n.Sprintf("✅ Produto '%s' removido!", line.Name))
+}
+
+func (m *model) undoRedo(action func(now time.Time) (product.Event, error), done string) {
+	if _, err := action(clock.RealNow(m.clock)); err != nil {
+		m.status = i18n.Error(err)
+		return
+	}
+	m.clampRow()
+	m.commit(done)
+}
+
+func (m *model) selectID(id int) {
+	for i, line := range m.summary().Products {
+		if line.ID == id {
+			m.row = i
+			return
+		}
+	}
+	m.clampRow()
+}
+
+func (m *model) commit(status string) {
+	m.mode = modeBrowse
+	m.status = status
+	if m.save == nil {
+		return
+	}
+	if err := m.save(&m.list); err != nil {
+		m.status = i18n.T("Erro ao salvar os dados: ") + i18n.Error(err)
+	}
+	m.clampRow()
+}
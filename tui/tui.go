@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"golang.org/x/term"
+)
+
+var ErrNotTerminal = errors.New("a interface em tela cheia precisa de um terminal interativo")
+
+type Options struct {
+	Profile string
+	List    product.ProductList
+	Clock   clock.Clock
+	Save    func(*product.ProductList) error
+}
+
+func Run(opts Options) error {
+	in, out := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+	if !term.IsTerminal(in) || !term.IsTerminal(out) {
+		return ErrNotTerminal
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.System{}
+	}
+
+	state, err := term.MakeRaw(in)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(in, state)
+
+	w := bufio.NewWriter(os.Stdout)
+	fmt.Fprint(w, "\x1b[?1049h\x1b[?25l")
+	defer func() {
+		fmt.Fprint(w, "\x1b[?25h\x1b[?1049l")
+		w.Flush()
+	}()
+
+	m := newModel(opts)
+	buf := make([]byte, 64)
+	for !m.quit {
+		width, height, err := term.GetSize(out)
+		if err != nil {
+			width, height = minWidth, minHeight
+		}
+		m.render(w, width, height)
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return err
+		}
+		for _, k := range decodeKeys(buf[:n]) {
+			m.handle(k)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pedrorcruzz/smart-spending-checker/clock"
+	"github.com/pedrorcruzz/smart-spending-checker/i18n"
+	"github.com/pedrorcruzz/smart-spending-checker/money"
+	"github.com/pedrorcruzz/smart-spending-checker/product"
+	"github.com/pedrorcruzz/smart-spending-checker/report"
+)
+
+const (
+	styleReset   = "\x1b[0m"
+	styleBold    = "\x1b[1m"
+	styleDim     = "\x1b[2m"
+	styleReverse = "\x1b[7m"
+	styleRed     = "\x1b[31m"
+	styleGreen   = "\x1b[32m"
+)
+
+const (
+	minWidth  = 60
+	minHeight = 20
+	barWidth  = 40
+)
+
+func (m *model) render(w io.Writer, width, height int) {
+	width = max(width, minWidth)
+	height = max(height, minHeight)
+
+	summary := m.summary()
+	year, month := yearMonth(m.month)
+	divider := styleDim + strings.Repeat("─", width) + styleReset
+
+	header := []string{
+		m.title(),
+		m.timeline(width),
+		divider,
+		i18n.Sprintf("%s%s de %d%s — %d produto(s) ativo(s)", styleBold, i18n.MonthName(month), year, styleReset, len(summary.Products)),
+	}
+	footer := append([]string{divider}, m.summaryPanel(summary)...)
+	footer = append(footer, divider, m.statusLine(), styleDim+m.help()+styleReset)
+
+	tableHeight := height - len(header) - len(footer)
+	table := m.table(summary, width, tableHeight)
+	for len(table) < tableHeight {
+		table = append(table, "")
+	}
+
+	lines := append(header, table...)
+	lines = append(lines, footer...)
+
+	fmt.Fprint(w, "\x1b[H\x1b[2J")
+	fmt.Fprint(w, strings.Join(lines, "\x1b[K\r\n"))
+}
+
+func (m *model) title() string {
+	title := i18n.Sprintf("%s Gestor Inteligente de Gastos %s  Perfil: %s", styleReverse, styleReset, m.profile)
+	if clock.IsShifted(m.clock) {
+		now := m.clock.Now()
+		title += i18n.Sprintf("  🕒 Visualizando como em %02d/%d", int(now.Month()), now.Year())
+	}
+	return title
+}
+
+func (m *model) timeline(width int) string {
+	const cellWidth = 7
+	count := min((width-4)/cellWidth, 24)
+	start := m.month - count/3
+
+	now := m.clock.Now()
+	today := monthIndex(now.Year(), int(now.Month()))
+
+	var b strings.Builder
+	b.WriteString("◀ ")
+	for index := start; index < start+count; index++ {
+		year, month := yearMonth(index)
+		label := fmt.Sprintf("%02d/%02d", month, year%100)
+
+		marker := " "
+		if index == today {
+			marker = "•"
+		}
+
+		style := ""
+		if report.Summarize(m.list, year, month).Verdict != report.VerdictOK {
+			style = styleRed
+		}
+		if index == m.month {
+			style += styleReverse
+		}
+		b.WriteString(style + marker + label + " " + styleReset)
+	}
+	b.WriteString(" ▶")
+	return b.String()
+}
+
+func (m *model) table(summary report.MonthSummary, width, height int) []string {
+	nameWidth := min(width-42, 40)
+	lines := []string{styleBold + fmt.Sprintf("%-5s %s %14s %9s %14s", "ID", pad(i18n.T("Nome"), nameWidth), i18n.T("Parcela"), i18n.T("Nº"), i18n.T("Total")) + styleReset}
+	height--
+
+	editing := m.mode == modeEdit
+	adding := editing && m.form.id == 0
+
+	rows := len(summary.Products)
+	if adding {
+		rows++
+	}
+	if rows == 0 {
+		return append(lines, i18n.T("  Nenhum produto ativo neste mês. Pressione 'a' para adicionar."))
+	}
+
+	selected := m.row
+	if adding {
+		selected = rows - 1
+	}
+	offset := 0
+	if selected >= height {
+		offset = selected - height + 1
+	}
+
+	for i := offset; i < rows && i < offset+height; i++ {
+		if adding && i == rows-1 {
+			lines = append(lines, m.formRow(i18n.T("novo"), nameWidth))
+			continue
+		}
+
+		p := summary.Products[i]
+		if editing && p.ID == m.form.id {
+			lines = append(lines, m.formRow(strconv.Itoa(p.ID), nameWidth))
+			continue
+		}
+
+		row := fmt.Sprintf("%-5d %s %14s %9s %14s", p.ID, pad(p.Name, nameWidth),
+			money.Format(p.Parcel),
+			fmt.Sprintf("%d/%d", p.InstallmentNumber, p.Installments),
+			totalCell(p))
+		if i == m.row && !editing {
+			row = styleReverse + row + styleReset
+		}
+		lines = append(lines, row)
+	}
+	return lines
+}
+
+func (m *model) formRow(id string, nameWidth int) string {
+	f := m.form
+
+	cell := func(field, width int, alignRight bool) string {
+		value := f.values[field]
+		if field == f.field {
+			value += "▏"
+		}
+		if alignRight {
+			value = fmt.Sprintf("%*s", width, value)
+		} else {
+			value = pad(value, width)
+		}
+		if field == f.field {
+			return styleReverse + value + styleReset
+		}
+		return styleBold + value + styleReset
+	}
+
+	parcel := ""
+	total, errTotal := money.Parse(strings.TrimSpace(f.values[fieldTotal]))
+	installments, errInstallments := strconv.Atoi(strings.TrimSpace(f.values[fieldInstallments]))
+	if errTotal == nil && errInstallments == nil && installments > 0 {
+		p := product.Product{Installments: installments}
+		if idx, ok := m.list.FindByID(f.id); ok {
+			p = m.list.Products[idx]
+			p.Installments = installments
+		}
+		p.SetAmount(total)
+		parcel = money.Format(p.Parcel)
+	}
+
+	return fmt.Sprintf("%-5s %s %14s %s %s", id, cell(fieldName, nameWidth, false), parcel,
+		cell(fieldInstallments, 9, true), cell(fieldTotal, 14, true))
+}
+
+func (m *model) summaryPanel(s report.MonthSummary) []string {
+	limit := 100 - s.SafePercentage
+
+	used := int(s.UsedPercent / 100 * barWidth)
+	used = min(max(used, 0), barWidth)
+	mark := min(max(int(limit/100*barWidth), 0), barWidth-1)
+
+	var bar strings.Builder
+	for i := 0; i < barWidth; i++ {
+		switch {
+		case i == mark:
+			bar.WriteString(styleReset + styleBold + "│" + styleReset)
+		case i < used && i > mark:
+			bar.WriteString(styleRed + "█" + styleReset)
+		case i < used:
+			bar.WriteString(styleGreen + "█" + styleReset)
+		default:
+			bar.WriteString(styleDim + "·" + styleReset)
+		}
+	}
+
+	verdict := styleGreen + i18n.T("✅ Dentro da porcentagem segura.") + styleReset
+	if s.Verdict != report.VerdictOK {
+		verdict = styleRed + i18n.T("❌ Acima do limite: não é recomendado comprar mais parcelados neste mês.") + styleReset
+	}
+
+	return []string{
+		i18n.Sprintf("Lucro mensal: %s   Total de parcelas: %s   Pode gastar: %s", money.Format(s.MonthlyProfit), money.Format(s.TotalParcel), money.Format(s.RemainingSpendable)),
+		i18n.Sprintf("Usado: %6s%% [%s] limite de uso %s%% (porcentagem segura %s%%)", money.FormatPercent(s.UsedPercent, 2), bar.String(), money.FormatPercent(limit, 2), money.FormatPercent(s.SafePercentage, 2)),
+		verdict,
+	}
+}
+
+func (m *model) statusLine() string {
+	switch m.mode {
+	case modeConfirmRemove:
+		if line, ok := m.selected(); ok {
+			return i18n.Sprintf("Remover '%s'? (s/n)", line.Name)
+		}
+	case modeEdit:
+		if m.status == "" {
+			return i18n.T("Editando ") + strings.ToLower(i18n.T(fieldLabels[m.form.field]))
+		}
+	}
+	return m.status
+}
+
+func (m *model) help() string {
+	if m.mode == modeEdit {
+		return i18n.T("Tab/↑/↓ campo  Enter próximo/salvar  Backspace apagar  Esc cancelar")
+	}
+	return i18n.T("←/→ mês  ↑/↓ produto  h hoje  a adicionar  Enter/e editar  d remover  u desfazer  r refazer  q sair")
+}
+
+func pad(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	length := utf8.RuneCountInString(s)
+	if length > width {
+		runes := []rune(s)
+		return string(runes[:width-1]) + "…"
+	}
+	return s + strings.Repeat(" ", width-length)
+}
+
+func totalCell(p report.ProductLine) string {
+	if p.Currency == "" {
+		return money.Format(p.TotalValue)
+	}
+	return money.FormatIn(p.OriginalValue, p.Currency)
+}
@@ -1,9 +1,15 @@
 package utils
 
 import (
+	"bufio"
+	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
+
+	"golang.org/x/term"
 )
 
 func ClearTerminal() {
@@ -17,3 +23,21 @@ func ClearTerminal() {
 	cmd.Stdout = os.Stdout
 	cmd.Run()
 }
+
+func ReadPassword(in io.Reader, reader *bufio.Reader, out io.Writer) string {
+	if file, ok := in.(*os.File); ok && reader.Buffered() == 0 && term.IsTerminal(int(file.Fd())) {
+		password, err := term.ReadPassword(int(file.Fd()))
+		fmt.Fprintln(out)
+		if err == nil {
+			return string(password)
+		}
+	}
+
+	password, _ := reader.ReadString('\n')
+	return strings.TrimRight(password, "\r\n")
+}
+
+func IsTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(file.Fd())) && os.Getenv("NO_COLOR") == ""
+}
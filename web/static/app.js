@@ -0,0 +1,323 @@
+"use strict";
+
+const tokenKey = "ssc-token";
+const money = new Intl.NumberFormat("pt-BR", { style: "currency", currency: "BRL" });
+const monthNames = ["jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"];
+
+const state = { year: 0, month: 0 };
+
+const $ = (id) => document.getElementById(id);
+const field = (form, name) => form.elements.namedItem(name);
+
+async function request(method, path, body) {
+  for (let attempt = 0; attempt < 2; attempt++) {
+    const headers = { "Content-Type": "application/json" };
+    const token = localStorage.getItem(tokenKey);
+    if (token) {
+      headers.Authorization = "Bearer " + token;
+    }
+
+    const response = await fetch(path, {
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+
+    if (response.status === 401 && attempt === 0) {
+      const typed = prompt("Token de acesso:");
+      if (!typed) {
+        throw new Error("Token de acesso necessário.");
+      }
+      localStorage.setItem(tokenKey, typed.trim());
+      continue;
+    }
+    if (response.status === 204) {
+      return null;
+    }
+
+    const data = await response.json().catch(() => ({}));
+    if (!response.ok) {
+      throw new Error(data.error || response.statusText);
+    }
+    return data;
+  }
+  throw new Error("Token de acesso inválido.");
+}
+
+function parseAmount(text) {
+  const value = String(text).replace(/R\$|\s/g, "");
+  if (!/^[\d.,]+$/.test(value)) {
+    return NaN;
+  }
+
+  let decimal = Math.max(value.lastIndexOf("."), value.lastIndexOf(","));
+  if (decimal >= 0 && !(value.includes(".") && value.includes(","))) {
+    const separator = value[decimal];
+    if (value.split(separator).length > 2 || (separator === "." && value.length - decimal - 1 === 3)) {
+      decimal = -1;
+    }
+  }
+
+  let normalized = "";
+  for (let i = 0; i < value.length; i++) {
+    const char = value[i];
+    if (i === decimal) {
+      normalized += ".";
+    } else if (char === "." || char === ",") {
+      const group = value.slice(i + 1).match(/^\d*/)[0];
+      if (i === 0 || group.length !== 3) {
+        return NaN;
+      }
+    } else {
+      normalized += char;
+    }
+  }
+  return Number(normalized);
+}
+
+function formatAmount(value) {
+  return value.toLocaleString("pt-BR", { minimumFractionDigits: 2, maximumFractionDigits: 2 });
+}
+
+function monthParam(year, month) {
+  return `${year}-${String(month).padStart(2, "0")}`;
+}
+
+function showMessage(text, error) {
+  const message = $("message");
+  message.textContent = text;
+  message.className = error ? "message error" : "message";
+  message.hidden = !text;
+}
+
+function limitOf(summary) {
+  return (summary.monthly_profit * (100 - summary.safe_percentage)) / 100;
+}
+
+function renderSummary(summary) {
+  $("month-label").textContent = `${monthNames[summary.month - 1]}/${summary.year}`;
+  $("profit").textContent = money.format(summary.monthly_profit);
+  $("total").textContent = money.format(summary.total_parcel);
+  $("used").textContent = `${summary.used_percent.toFixed(2)}%`;
+  $("remaining").textContent = money.format(summary.remaining_spendable);
+
+  const verdict = $("verdict");
+  const ok = summary.verdict === "ok";
+  verdict.className = ok ? "card verdict ok" : "card verdict over";
+  verdict.textContent = ok
+    ? `✅ Dentro da porcentagem segura (${summary.safe_percentage.toFixed(0)}%)`
+    : `⚠️ Acima do limite: menos de ${summary.safe_percentage.toFixed(0)}% do lucro disponível`;
+
+  const settings = $("settings-form");
+  field(settings, "monthly_profit").value = formatAmount(summary.monthly_profit);
+  field(settings, "safe_percentage").value = summary.safe_percentage.toFixed(0);
+}
+
+function renderProducts(products) {
+  const body = $("products");
+  body.replaceChildren();
+
+  if (products.length === 0) {
+    const row = body.insertRow();
+    const cell = row.insertCell();
+    cell.colSpan = 5;
+    cell.className = "empty";
+    cell.textContent = "Nenhum produto neste mês.";
+    return;
+  }
+
+  for (const p of products) {
+    const row = body.insertRow();
+    const paid = p.paid ? " ✔ paga" : "";
+    row.insertCell().textContent = p.category ? `${p.name} (${p.category})` : p.name;
+    row.insertCell().textContent = p.card || "";
+    row.insertCell().textContent = `${money.format(p.parcel)} (${p.installment_number}/${p.installments})${paid}`;
+    row.insertCell().textContent = p.currency
+      ? `${p.currency} ${formatAmount(p.original_value)} (${money.format(p.total_value)})`
+      : money.format(p.total_value);
+
+    const actions = row.insertCell();
+    actions.className = "row-actions";
+    const edit = document.createElement("button");
+    edit.type = "button";
+    edit.textContent = "Editar";
+    edit.addEventListener("click", () => startEdit(p));
+    const remove = document.createElement("button");
+    remove.type = "button";
+    remove.className = "danger";
+    remove.textContent = "Remover";
+    remove.addEventListener("click", () => removeProduct(p));
+    actions.append(edit, remove);
+  }
+}
+
+function renderChart(summaries) {
+  const width = 720;
+  const height = 260;
+  const top = 16;
+  const bottom = 32;
+  const left = 8;
+  const plotHeight = height - top - bottom;
+  const slot = (width - left * 2) / summaries.length;
+
+  const max = Math.max(1, ...summaries.map((s) => Math.max(s.total_parcel, s.monthly_profit)));
+  const y = (value) => top + plotHeight - (value / max) * plotHeight;
+
+  const svg = [`<svg viewBox="0 0 ${width} ${height}" role="img" aria-label="Parcelas dos próximos 12 meses">`];
+  summaries.forEach((s, i) => {
+    const x = left + i * slot;
+    const bar = slot * 0.36;
+    const over = s.verdict !== "ok";
+    const label = `${monthNames[s.month - 1]}/${String(s.year).slice(2)}`;
+
+    svg.push(`<rect class="income" x="${x + slot * 0.1}" y="${y(s.monthly_profit)}" width="${bar}" height="${y(0) - y(s.monthly_profit)}"><title>Lucro mensal: ${money.format(s.monthly_profit)}</title></rect>`);
+    svg.push(`<rect class="${over ? "over" : "installments"}" x="${x + slot * 0.1 + bar}" y="${y(s.total_parcel)}" width="${bar}" height="${y(0) - y(s.total_parcel)}"><title>${label}: ${money.format(s.total_parcel)} (${s.used_percent.toFixed(1)}%)</title></rect>`);
+
+    const limit = y(limitOf(s));
+    svg.push(`<line class="limit" x1="${x}" x2="${x + slot}" y1="${limit}" y2="${limit}"><title>Limite: ${money.format(limitOf(s))}</title></line>`);
+    svg.push(`<text x="${x + slot / 2}" y="${height - 10}" text-anchor="middle"${over ? ' class="over"' : ""}>${label}</text>`);
+  });
+  svg.push(`<line class="axis" x1="0" x2="${width}" y1="${y(0)}" y2="${y(0)}"></line>`);
+  svg.push("</svg>");
+
+  $("chart").innerHTML = svg.join("");
+}
+
+async function load() {
+  try {
+    const query = state.year ? `month=${monthParam(state.year, state.month)}&` : "";
+    const summaries = await request("GET", `/api/summary?${query}months=12`);
+    state.year = summaries[0].year;
+    state.month = summaries[0].month;
+
+    const products = await request("GET", `/api/products?month=${monthParam(state.year, state.month)}`);
+    renderSummary(summaries[0]);
+    renderChart(summaries);
+    renderProducts(products);
+  } catch (err) {
+    showMessage(err.message, true);
+  }
+}
+
+function moveMonth(delta) {
+  const date = new Date(state.year, state.month - 1 + delta, 1);
+  state.year = date.getFullYear();
+  state.month = date.getMonth() + 1;
+  load();
+}
+
+function goToday() {
+  state.year = 0;
+  state.month = 0;
+  load();
+}
+
+function startEdit(p) {
+  const form = $("product-form");
+  field(form, "product_id").value = p.id;
+  field(form, "name").value = p.name;
+  field(form, "total_value").value = formatAmount(p.currency ? p.original_value : p.total_value);
+  form.dataset.currency = p.currency || "";
+  field(form, "installments").value = p.installments;
+  field(form, "category").value = p.category || "";
+  field(form, "card").value = p.card || "";
+  form.classList.add("editing");
+  $("product-form-title").textContent = `Editar ${p.name}`;
+  $("product-submit").textContent = "Salvar";
+  $("product-cancel").hidden = false;
+  form.scrollIntoView({ behavior: "smooth" });
+}
+
+function resetForm() {
+  const form = $("product-form");
+  form.reset();
+  field(form, "product_id").value = "";
+  form.dataset.currency = "";
+  form.classList.remove("editing");
+  $("product-form-title").textContent = "Adicionar produto";
+  $("product-submit").textContent = "Adicionar";
+  $("product-cancel").hidden = true;
+}
+
+async function saveProduct(event) {
+  event.preventDefault();
+  const form = event.target;
+
+  const id = field(form, "product_id").value;
+  const total = parseAmount(field(form, "total_value").value);
+  if (Number.isNaN(total)) {
+    showMessage("Valor inválido.", true);
+    return;
+  }
+
+  const body = {
+    name: field(form, "name").value.trim(),
+    installments: Number(field(form, "installments").value),
+    category: field(form, "category").value.trim(),
+    card: field(form, "card").value.trim(),
+  };
+
+  try {
+    if (id) {
+      body[form.dataset.currency ? "original_value" : "total_value"] = total;
+      await request("PATCH", `/api/products/${id}`, body);
+      showMessage("✅ Produto atualizado!");
+    } else {
+      const currency = field(form, "currency").value.trim();
+      body[currency ? "original_value" : "total_value"] = total;
+      if (currency) {
+        body.currency = currency;
+        body.with_iof = field(form, "with_iof").checked;
+      }
+      const added = await request("POST", "/api/products", body);
+      showMessage(`✅ Produto adicionado! Parcela mensal: ${money.format(added.parcel)}`);
+    }
+    resetForm();
+    load();
+  } catch (err) {
+    showMessage(err.message, true);
+  }
+}
+
+async function removeProduct(p) {
+  if (!confirm(`Remover '${p.name}'?`)) {
+    return;
+  }
+  try {
+    await request("DELETE", `/api/products/${p.id}`);
+    showMessage(`✅ Produto '${p.name}' removido!`);
+    load();
+  } catch (err) {
+    showMessage(err.message, true);
+  }
+}
+
+async function saveSettings(event) {
+  event.preventDefault();
+  const form = event.target;
+
+  const profit = parseAmount(field(form, "monthly_profit").value);
+  const safe = parseAmount(field(form, "safe_percentage").value);
+  if (Number.isNaN(profit) || Number.isNaN(safe)) {
+    showMessage("Valor inválido.", true);
+    return;
+  }
+
+  try {
+    await request("PUT", "/api/income", { monthly_profit: profit });
+    await request("PUT", "/api/safe-percentage", { safe_percentage: safe });
+    showMessage("✅ Configurações salvas!");
+    load();
+  } catch (err) {
+    showMessage(err.message, true);
+  }
+}
+
+$("prev-month").addEventListener("click", () => moveMonth(-1));
+$("next-month").addEventListener("click", () => moveMonth(1));
+$("current-month").addEventListener("click", goToday);
+$("product-form").addEventListener("submit", saveProduct);
+$("product-cancel").addEventListener("click", resetForm);
+$("settings-form").addEventListener("submit", saveSettings);
+
+goToday();